@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package gowild
+
+import "github.com/twinfer/gowild/internal/wildcard"
+
+// ErrTooComplex indicates that comparing two patterns would take exponential
+// time, as with stars followed by long runs of single-character wildcards.
+var ErrTooComplex = wildcard.ErrTooComplex
+
+// Subsumes reports whether pattern a matches every string that pattern b
+// matches, so that a rule for b is redundant next to a rule for a. The answer is
+// computed from the patterns alone, by exploring automata built from them, and
+// covers every wildcard and class syntax. Like ToRegexp, it compares the
+// patterns case-sensitively, character by character: it agrees with Match on
+// ASCII text, while for other text `?`, `.` and classes consume whole runes as
+// in MatchFold. Options such as WithSeparator, WithStrictQuestion and
+// WithLiteralDot apply to both patterns; options that change what a character
+// is, such as WithGraphemes, yield an error wrapping errors.ErrUnsupported, a
+// malformed pattern a *PatternError wrapping ErrBadPattern, and patterns whose
+// automata grow too large to explore ErrTooComplex.
+//
+// Example:
+//
+//	Subsumes("*", "admin-*")                             // true
+//	Subsumes("admin-*", "*")                             // false
+//	Subsumes("src/**", "src/*/*.go", WithSeparator('/')) // true
+//	Subsumes("src/*", "src/*/*.go", WithSeparator('/'))  // false
+func Subsumes(a, b string, opts ...Option) (bool, error) {
+	pa, pb, err := compilePair(a, b, opts)
+	if err != nil {
+		return false, err
+	}
+	return wildcard.Subsumes(pa, pb)
+}
+
+// Overlaps reports whether patterns a and b match a common string, and returns
+// the shortest such string as an example. It accepts the same patterns and
+// options as Subsumes.
+//
+// Example:
+//
+//	Overlaps("admin-*", "*-root") // "admin-root", true
+//	Overlaps("admin-*", "user-*") // "", false
+func Overlaps(a, b string, opts ...Option) (string, bool, error) {
+	pa, pb, err := compilePair(a, b, opts)
+	if err != nil {
+		return "", false, err
+	}
+	return wildcard.Overlaps(pa, pb)
+}
+
+// Equivalent reports whether patterns a and b match exactly the same strings.
+// It accepts the same patterns and options as Subsumes.
+//
+// Example:
+//
+//	Equivalent("{a,}{b,}", "{ab,a,b,}") // true
+//	Equivalent("a*", "a?*")              // true
+//	Equivalent("a*", "a?")               // false
+func Equivalent(a, b string, opts ...Option) (bool, error) {
+	pa, pb, err := compilePair(a, b, opts)
+	if err != nil {
+		return false, err
+	}
+	return wildcard.Equivalent(pa, pb)
+}
+
+// compilePair compiles two patterns for analysis.
+func compilePair(a, b string, opts []Option) (*wildcard.Program, *wildcard.Program, error) {
+	o := buildOptions(opts)
+	pa, err := wildcard.CompileFold(a, false, o)
+	if err != nil {
+		return nil, nil, err
+	}
+	pb, err := wildcard.CompileFold(b, false, o)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pa, pb, nil
+}
@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package gowild
+
+import (
+	"math/rand"
+
+	"github.com/twinfer/gowild/internal/wildcard"
+)
+
+// Examples returns up to n distinct random strings matched by pattern, for test
+// fixtures, previews and fuzzing corpora. Wildcards and classes draw their
+// characters from rng, mostly printable ASCII, `?` and brace alternatives are
+// taken or skipped at random, and `.` never yields a newline. Fewer than n
+// strings are returned when the pattern matches fewer. Examples accepts the same
+// patterns and options as Subsumes, and the strings it returns match the pattern
+// under Match whenever they are ASCII.
+//
+// Example:
+//
+//	rng := rand.New(rand.NewSource(1))
+//	Examples("img-??.{jpg,png}", 3, rng, WithLiteralDot()) // e.g. ["img-XE.png" "img-G.png" "img-Z9.png"]
+func Examples(pattern string, n int, rng *rand.Rand, opts ...Option) ([]string, error) {
+	prog, err := wildcard.CompileFold(pattern, false, buildOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return wildcard.Examples(prog, n, rng)
+}
+
+// CounterExamples returns up to n distinct random strings rejected by pattern,
+// each a near miss: a matching string with one character inserted, deleted or
+// replaced. They make good negative test cases, as they exercise the boundaries
+// of the pattern rather than being trivially different. Fewer than n strings are
+// returned when few exist, and none when the pattern matches everything. It
+// accepts the same patterns and options as Examples.
+//
+// Example:
+//
+//	rng := rand.New(rand.NewSource(1))
+//	CounterExamples("admin-*", 3, rng) // e.g. ["admiAn-*" "adminj" "admin"]
+func CounterExamples(pattern string, n int, rng *rand.Rand, opts ...Option) ([]string, error) {
+	prog, err := wildcard.CompileFold(pattern, false, buildOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return wildcard.CounterExamples(prog, n, rng)
+}
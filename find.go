@@ -0,0 +1,50 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package gowild
+
+import "github.com/twinfer/gowild/internal/wildcard"
+
+// Index returns the byte offsets of the leftmost substring of s matching pattern,
+// with the case-sensitive semantics of Match. Among the matches starting at the
+// leftmost position, the longest is chosen, so `*` at either end of the pattern
+// extends the match as far as possible. It returns -1, -1 if no substring matches.
+//
+// When the pattern starts with a literal, candidate positions are located with
+// strings.Index or bytes.Index instead of being tried one by one.
+//
+// Example:
+//
+//	Index("err*:", "log: error 42: disk full") // 5, 14
+//	Index("warn*", "all good")                 // -1, -1
+func Index[T ~string | ~[]byte](pattern, s T, opts ...Option) (start, end int, err error) {
+	prog, err := wildcard.Compile(pattern, buildOptions(opts))
+	if err != nil {
+		return -1, -1, err
+	}
+	start, end = wildcard.IndexProgram(prog, s, 0)
+	return start, end, nil
+}
+
+// FindAllIndex returns the successive non-overlapping substrings of s matching
+// pattern, as index pairs chosen as in Index. If n >= 0, it returns at most n
+// matches; an empty match abutting a preceding match is ignored, as in
+// regexp.Regexp.FindAllIndex. A nil result indicates no match.
+//
+// Example:
+//
+//	FindAllIndex("id=[0-9]", "id=1 id=x id=7", -1) // [[0 4] [10 14]]
+func FindAllIndex[T ~string | ~[]byte](pattern, s T, n int, opts ...Option) ([][]int, error) {
+	prog, err := wildcard.Compile(pattern, buildOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return wildcard.FindAllProgram(prog, s, n), nil
+}
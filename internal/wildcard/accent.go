@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+// Package wildcard contains optimized wildcard matching implementations.
+// This file provides accent-insensitive matching. Precomposed Latin letters are
+// mapped to their base letter through accentTable and combining diacritical
+// marks are ignored, so "cafe", "café" and "café" all compare equal. A
+// base letter and the combining marks following it form a single character, so
+// `.` and `?` still consume one user-visible character whatever its encoding.
+package wildcard
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// combiningMarks lists the blocks of combining diacritical marks ignored when
+// matching without accents. Marks of other scripts, such as Indic vowel signs,
+// change the letter they follow and are kept.
+var combiningMarks = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0300, Hi: 0x036F, Stride: 1}, // Combining Diacritical Marks
+		{Lo: 0x1AB0, Hi: 0x1AFF, Stride: 1}, // Combining Diacritical Marks Extended
+		{Lo: 0x1DC0, Hi: 0x1DFF, Stride: 1}, // Combining Diacritical Marks Supplement
+		{Lo: 0x20D0, Hi: 0x20FF, Stride: 1}, // Combining Diacritical Marks for Symbols
+		{Lo: 0xFE20, Hi: 0xFE2F, Stride: 1}, // Combining Half Marks
+	},
+}
+
+// isCombiningMark reports whether r is a combining diacritical mark.
+func isCombiningMark(r rune) bool {
+	return r >= 0x0300 && unicode.Is(combiningMarks, r)
+}
+
+// baseLetter returns the base letter of a precomposed Latin letter, or r itself.
+func baseLetter(r rune) rune {
+	if r < accentMin {
+		return r
+	}
+	if b, ok := accentTable[r]; ok {
+		return b
+	}
+	return r
+}
+
+// skipMarks returns the offset of the first rune at or after s[i] that is not
+// a combining diacritical mark.
+func skipMarks[T ~string | ~[]byte](s T, i int) int {
+	for i < len(s) && s[i] >= utf8.RuneSelf {
+		r, width := decodeRune(s, i)
+		if !isCombiningMark(r) {
+			break
+		}
+		i += width
+	}
+	return i
+}
+
+// stripAccents returns lit with precomposed Latin letters replaced by their base
+// letter and combining diacritical marks removed. Invalid bytes are decoded as
+// by the matcher: with opaque set they are copied through unchanged.
+func stripAccents(lit string, opaque bool) string {
+	var b strings.Builder
+	b.Grow(len(lit))
+	for i := 0; i < len(lit); {
+		r, width := decodeChar(lit, i, opaque)
+		switch {
+		case r > utf8.MaxRune:
+			b.WriteString(lit[i : i+width])
+		case !isCombiningMark(r):
+			b.WriteRune(baseLetter(r))
+		}
+		i += width
+	}
+	return b.String()
+}
@@ -0,0 +1,112 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package wildcard
+
+import (
+	"slices"
+	"testing"
+)
+
+// accentCases validates accent-insensitive matching, with "e\u0301" spelling 'é'
+// as a base letter and a combining mark
+var accentCases = []struct {
+	pattern string
+	input   string
+	fold    bool
+	want    bool
+}{
+	// Precomposed and decomposed letters match their base letter
+	{"cafe*", "cafe\u0301 au lait", false, true},
+	{"cafe*", "café au lait", false, true},
+	{"cafe\u0301", "cafe", false, true},
+	{"café", "cafe\u0301", false, true},
+	{"re\u0301sume\u0301", "resume", false, true},
+	{"Ångström", "Angstrom", false, true},
+	{"\u01D6", "u", false, true}, // Several marks
+	{"CAFE", "cafe\u0301", false, false},
+	{"CAFE", "cafe\u0301", true, true},
+	{"CAFE", "CAFÉ", false, true},
+
+	// Letters without a decomposition keep their identity
+	{"ss", "ß", true, false},
+	{"o", "ø", false, false},
+	{"a", "α", false, false},
+
+	// `.` and `?` consume a letter together with its marks
+	{"caf.", "cafe\u0301", false, true},
+	{"caf?", "cafe\u0301", false, true},
+	{"caf..", "cafe\u0301", false, false},
+	{"caf??", "cafe\u0301", false, true},
+	{"*e", "cafe\u0301", false, true},
+	{"*\u0301", "café", false, true}, // A lone mark in the pattern is ignored too
+	{"n.o", "nệo", false, true},
+
+	// Classes test the letter and its base letter
+	{"caf[a-z]", "cafe\u0301", false, true},
+	{"caf[a-z]", "café", false, true},
+	{"caf[é]", "café", false, true},
+	{"caf[!e]", "cafe\u0301", false, false},
+	{"caf[!e]", "café", false, false},
+	{"caf[A-Z]", "cafe\u0301", true, false},
+	{"{cafe,bar}", "Cafe\u0301", true, true},
+}
+
+// TestIgnoreAccents validates IgnoreAccents in the matching engines and in compiled programs
+func TestIgnoreAccents(t *testing.T) {
+	opts := Options{IgnoreAccents: true}
+	for _, c := range accentCases {
+		if got, err := MatchInternalFoldWithOptions(c.pattern, c.input, c.fold, opts); err != nil || got != c.want {
+			t.Errorf("MatchInternalFoldWithOptions(%q, %q, %v): expected %v, got %v, %v", c.pattern, c.input, c.fold, c.want, got, err)
+		}
+		if got, err := MatchInternalFoldWithOptions([]byte(c.pattern), []byte(c.input), c.fold, opts); err != nil || got != c.want {
+			t.Errorf("MatchInternalFoldWithOptions([]byte %q, %q, %v): expected %v, got %v, %v", c.pattern, c.input, c.fold, c.want, got, err)
+		}
+		if !c.fold {
+			if got, err := MatchInternalWithOptions(c.pattern, c.input, opts); err != nil || got != c.want {
+				t.Errorf("MatchInternalWithOptions(%q, %q): expected %v, got %v, %v", c.pattern, c.input, c.want, got, err)
+			}
+		}
+	}
+}
+
+// TestIgnoreAccentsFind validates that matches never split a letter from its marks
+func TestIgnoreAccentsFind(t *testing.T) {
+	prog, _ := CompileFold("e*", false, Options{IgnoreAccents: true})
+	s := "café été"
+	if got := FindAllProgram(prog, s, -1); len(got) != 1 || !slices.Equal(got[0], []int{3, 14}) {
+		t.Errorf("FindAllProgram(%q): expected [[3 14]], got %v", s, got)
+	}
+
+	prog, _ = CompileFold("e", false, Options{IgnoreAccents: true})
+	want := [][]int{{3, 6}, {7, 10}, {11, 14}}
+	if got := FindAllProgram(prog, s, -1); len(got) != len(want) || !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("FindAllProgram(%q): expected %v, got %v", s, want, got)
+	}
+
+	prog, _ = CompileFold("?", false, Options{IgnoreAccents: true})
+	if got := MatchProgramSubmatch(prog, "é"); !slices.Equal(got, []int{0, 3, 0, 3}) {
+		t.Errorf("MatchProgramSubmatch: expected [0 3 0 3], got %v", got)
+	}
+}
+
+// TestIgnoreAccentsSet validates that pattern sets do not index literals without accents
+func TestIgnoreAccentsSet(t *testing.T) {
+	p1, _ := CompileFold("cafe*", true, Options{IgnoreAccents: true})
+	p2, _ := CompileFold("*creme", true, Options{IgnoreAccents: true})
+	set := NewSet([]*Program{p1, p2})
+
+	if got := SetMatches(set, "Cafe\u0301 noir", nil); !slices.Equal(got, []int{0}) {
+		t.Errorf("Expected [0], got %v", got)
+	}
+	if got := SetMatches(set, "crème brûle\u0301e crème", nil); !slices.Equal(got, []int{1}) {
+		t.Errorf("Expected [1], got %v", got)
+	}
+}
@@ -0,0 +1,424 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+// Package wildcard contains optimized wildcard matching implementations.
+// This file provides the analysis of pattern languages: whether one pattern
+// matches every string another one does, whether two patterns match a common
+// string, and whether they match exactly the same strings. Each Program is
+// turned into a nondeterministic automaton over runes, and the automata are
+// explored together through an on-the-fly subset construction, so no pattern is
+// ever expanded into the strings it matches.
+package wildcard
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Subsumes reports whether every string matched by b is also matched by a. The
+// strings considered are the valid UTF-8 ones, on which both programs must be
+// Unicode-aware; options that change what a character is, such as full case
+// folding or grapheme clusters, are reported as an error wrapping
+// errors.ErrUnsupported. Patterns whose comparison would take exponential time
+// are reported as ErrTooComplex.
+func Subsumes(a, b *Program) (bool, error) {
+	_, found, err := Difference(b, a)
+	if err != nil {
+		return false, err
+	}
+	return !found, nil
+}
+
+// Difference returns the shortest string matched by a but not by b, if any. It
+// accepts the same programs as Subsumes.
+func Difference(a, b *Program) (string, bool, error) {
+	na, nb, err := newNFAs(a, b)
+	if err != nil {
+		return "", false, err
+	}
+	return search(na, nb, func(sa, sb []int) (goal, prune bool) {
+		return na.accepts(sa) && !nb.accepts(sb), len(sa) == 0
+	})
+}
+
+// Overlaps reports whether a and b match a common string, and returns the
+// shortest one. It accepts the same programs as Subsumes.
+func Overlaps(a, b *Program) (string, bool, error) {
+	na, nb, err := newNFAs(a, b)
+	if err != nil {
+		return "", false, err
+	}
+	return search(na, nb, func(sa, sb []int) (goal, prune bool) {
+		return na.accepts(sa) && nb.accepts(sb), len(sa) == 0 || len(sb) == 0
+	})
+}
+
+// Equivalent reports whether a and b match exactly the same strings. It accepts
+// the same programs as Subsumes.
+func Equivalent(a, b *Program) (bool, error) {
+	na, nb, err := newNFAs(a, b)
+	if err != nil {
+		return false, err
+	}
+	_, found, err := search(na, nb, func(sa, sb []int) (goal, prune bool) {
+		return na.accepts(sa) != nb.accepts(sb), len(sa) == 0 && len(sb) == 0
+	})
+	return !found && err == nil, err
+}
+
+// ErrTooComplex is returned by pattern analysis when the two patterns reach more
+// than maxAnalysisStates pairs of state sets, as stars followed by long runs of
+// single-character wildcards can: the number of pairs grows exponentially with
+// the length of such runs.
+var ErrTooComplex = errors.New("patterns too complex to analyze")
+
+// maxAnalysisStates bounds the pairs of state sets explored by search, keeping
+// the time and memory spent on any pair of patterns to a fraction of a second.
+const maxAnalysisStates = 1 << 14
+
+// analysisError reports a program that pattern analysis does not support.
+type analysisError struct {
+	feature string
+}
+
+func (e *analysisError) Error() string {
+	return "pattern analysis does not support " + e.feature
+}
+
+func (e *analysisError) Unwrap() error {
+	return errors.ErrUnsupported
+}
+
+// checkAnalyzable returns an error if the automaton built from p would not
+// match the strings p matches.
+func checkAnalyzable(p *Program) error {
+	switch {
+	case !p.unicode:
+		return &analysisError{"byte-oriented matching"}
+	case p.fullFold:
+		return &analysisError{"full case folding"}
+	case p.ignoreAccents:
+		return &analysisError{"accent-insensitive matching"}
+	case p.graphemes:
+		return &analysisError{"grapheme clusters"}
+	case p.normalize:
+		return &analysisError{"normalization-insensitive matching"}
+	case p.invalid != UTF8Replace:
+		return &analysisError{"invalid UTF-8 policy"}
+	}
+	return nil
+}
+
+// nfa is a nondeterministic automaton over runes matching the same strings as a
+// Program. State pc is where the instruction at pc starts matching, so the state
+// numbered len(insts) is the only accepting one; further states are added
+// inside literals and wildcards consuming several characters.
+type nfa struct {
+	states []nfaState
+	accept int
+}
+
+// nfaState is a state of an nfa.
+type nfaState struct {
+	eps   []int     // States reached without consuming input
+	edges []nfaEdge // Transitions consuming a single rune
+}
+
+// nfaEdge is a transition consuming a rune of set.
+type nfaEdge struct {
+	set runeSet
+	to  int
+}
+
+// newNFAs builds the automata of a and b.
+func newNFAs(a, b *Program) (*nfa, *nfa, error) {
+	na, err := newNFA(a)
+	if err != nil {
+		return nil, nil, err
+	}
+	nb, err := newNFA(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	return na, nb, nil
+}
+
+// newNFA builds the automaton of p, following the semantics of the matcher in
+// exec.go instruction by instruction.
+func newNFA(p *Program) (*nfa, error) {
+	if err := checkAnalyzable(p); err != nil {
+		return nil, err
+	}
+	n := &nfa{states: make([]nfaState, len(p.insts)+1), accept: len(p.insts)}
+
+	// Wildcards and classes never consume the separator
+	nonSep := validRunes
+	var sepSet runeSet
+	if p.sep != 0 {
+		sepSet = runeSetOf(p.sep)
+		nonSep = nonSep.minus(sepSet)
+	}
+
+	for pc := range p.insts {
+		in := &p.insts[pc]
+		next := pc + 1
+		switch in.op {
+		case opLiteral:
+			from := pc
+			for i := 0; i < len(in.lit); {
+				r, width := utf8.DecodeRuneInString(in.lit[i:])
+				i += width
+				set := literalSet(p, r)
+				if r == utf8.RuneError && width == 1 && !p.fold {
+					// An invalid byte only matches itself, which is not valid UTF-8
+					set = nil
+				}
+				to := next
+				if i < len(in.lit) {
+					to = n.newState()
+				}
+				n.addEdge(from, set, to)
+				from = to
+			}
+			if len(in.lit) == 0 {
+				n.addEps(pc, next)
+			}
+
+		case opDot:
+			n.addEdge(pc, nonSep.minus(runeSetOf('\n')), next)
+
+		case opAny:
+			n.addEdge(pc, nonSep, next)
+
+		case opClass:
+			n.addEdge(pc, classSet(p, in.classFold).intersect(nonSep), next)
+
+		case opStar:
+			from := pc
+			for range in.min {
+				to := n.newState()
+				n.addEdge(from, nonSep, to)
+				from = to
+			}
+			n.addEdge(from, nonSep, from)
+			n.addEps(from, next)
+
+		case opGlobstar:
+			if !in.dirs {
+				n.addEdge(pc, validRunes, pc)
+				n.addEps(pc, next)
+				break
+			}
+			// Zero or more segments, each ending with the separator
+			inSegment := n.newState()
+			n.addEps(pc, next)
+			n.addEdge(pc, sepSet, pc)
+			n.addEdge(pc, nonSep, inSegment)
+			n.addEdge(inSegment, nonSep, inSegment)
+			n.addEdge(inSegment, sepSet, pc)
+
+		case opQuestion:
+			from := pc
+			for i := 0; ; i++ {
+				if i >= in.min {
+					n.addEps(from, next)
+				}
+				if i == in.n {
+					break
+				}
+				to := n.newState()
+				n.addEdge(from, nonSep, to)
+				from = to
+			}
+
+		case opAlt:
+			for _, target := range in.alts {
+				n.addEps(pc, target)
+			}
+
+		case opJump:
+			n.addEps(pc, in.jump)
+		}
+	}
+	return n, nil
+}
+
+// newState adds a state to n and returns its number.
+func (n *nfa) newState() int {
+	n.states = append(n.states, nfaState{})
+	return len(n.states) - 1
+}
+
+// addEdge adds a transition from one state to another consuming a rune of set.
+func (n *nfa) addEdge(from int, set runeSet, to int) {
+	if len(set) > 0 {
+		n.states[from].edges = append(n.states[from].edges, nfaEdge{set: set, to: to})
+	}
+}
+
+// addEps adds a transition from one state to another consuming no input.
+func (n *nfa) addEps(from, to int) {
+	n.states[from].eps = append(n.states[from].eps, to)
+}
+
+// closure returns the sorted set of states reachable from set without
+// consuming input.
+func (n *nfa) closure(set []int) []int {
+	seen := make(map[int]bool, len(set))
+	stack := slices.Clone(set)
+	var out []int
+	for len(stack) > 0 {
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if seen[s] {
+			continue
+		}
+		seen[s] = true
+		out = append(out, s)
+		stack = append(stack, n.states[s].eps...)
+	}
+	slices.Sort(out)
+	return out
+}
+
+// step returns the closure of the states reached from set by consuming r.
+func (n *nfa) step(set []int, r rune) []int {
+	var to []int
+	for _, s := range set {
+		for _, e := range n.states[s].edges {
+			if e.set.contains(r) {
+				to = append(to, e.to)
+			}
+		}
+	}
+	return n.closure(to)
+}
+
+// accepts reports whether set contains the accepting state.
+func (n *nfa) accepts(set []int) bool {
+	_, found := slices.BinarySearch(set, n.accept)
+	return found
+}
+
+// boundaries appends the runes where a transition leaving set starts or stops
+// accepting runes.
+func (n *nfa) boundaries(points []rune, set []int) []rune {
+	for _, s := range set {
+		for _, e := range n.states[s].edges {
+			for _, rg := range e.set {
+				points = append(points, rg.lo, rg.hi+1)
+			}
+		}
+	}
+	return points
+}
+
+// searchNode is a pair of state sets visited by search.
+type searchNode struct {
+	sa, sb []int
+	parent int  // Index of the node it was reached from, -1 for the start
+	r      rune // Rune consumed from the parent
+}
+
+// search explores the pairs of state sets that a and b reach on the same
+// strings, breadth first, and returns the shortest string reaching a pair for
+// which check reports a goal. Pairs for which it reports prune are not explored
+// further. It returns ErrTooComplex once more than maxAnalysisStates pairs are
+// reached.
+//
+// From each pair, the runes are split into intervals on which every transition
+// of both sets behaves the same, so a single rune stands for each interval and
+// the search stays independent of the size of the classes involved.
+func search(a, b *nfa, check func(sa, sb []int) (goal, prune bool)) (string, bool, error) {
+	start := searchNode{sa: a.closure([]int{0}), sb: b.closure([]int{0}), parent: -1}
+	nodes := []searchNode{start}
+	seen := map[string]bool{nodeKey(start.sa, start.sb): true}
+
+	for i := 0; i < len(nodes); i++ {
+		node := nodes[i]
+		goal, prune := check(node.sa, node.sb)
+		if goal {
+			return witness(nodes, i), true, nil
+		}
+		if prune {
+			continue
+		}
+
+		points := b.boundaries(a.boundaries(nil, node.sa), node.sb)
+		slices.Sort(points)
+		points = slices.Compact(points)
+		for j := 0; j+1 < len(points); j++ {
+			lo, hi := points[j], points[j+1]-1
+			sa, sb := a.step(node.sa, lo), b.step(node.sb, lo)
+			if len(sa) == 0 && len(sb) == 0 {
+				continue
+			}
+			key := nodeKey(sa, sb)
+			if seen[key] {
+				continue
+			}
+			if len(nodes) == maxAnalysisStates {
+				return "", false, ErrTooComplex
+			}
+			seen[key] = true
+			nodes = append(nodes, searchNode{sa: sa, sb: sb, parent: i, r: representative(lo, hi)})
+		}
+	}
+	return "", false, nil
+}
+
+// nodeKey returns a string identifying a pair of state sets.
+func nodeKey(sa, sb []int) string {
+	var b []byte
+	for _, s := range sa {
+		b = strconv.AppendInt(b, int64(s), 10)
+		b = append(b, ',')
+	}
+	b = append(b, '|')
+	for _, s := range sb {
+		b = strconv.AppendInt(b, int64(s), 10)
+		b = append(b, ',')
+	}
+	return string(b)
+}
+
+// witness returns the string consumed on the way to nodes[i].
+func witness(nodes []searchNode, i int) string {
+	var runes []rune
+	for ; nodes[i].parent >= 0; i = nodes[i].parent {
+		runes = append(runes, nodes[i].r)
+	}
+	slices.Reverse(runes)
+	var b strings.Builder
+	for _, r := range runes {
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// representative picks the rune standing for the interval from lo to hi in a
+// witness, preferring letters and digits so that witnesses stay readable.
+func representative(lo, hi rune) rune {
+	for _, rg := range []runeRange{{'a', 'z'}, {'0', '9'}, {'A', 'Z'}, {'!', '~'}} {
+		if lo <= rg.hi && hi >= rg.lo {
+			return max(lo, rg.lo)
+		}
+	}
+	for r := lo; r <= hi && r < lo+256; r++ {
+		if unicode.IsGraphic(r) {
+			return r
+		}
+	}
+	return lo
+}
@@ -0,0 +1,237 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package wildcard
+
+import (
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+)
+
+// analysisCases validates subsumption and overlap on patterns of every kind
+var analysisCases = []struct {
+	a, b     string
+	opts     Options
+	subsumes bool // a matches every string b matches
+	overlaps bool
+}{
+	// Stars and literals
+	{"*", "admin-*", Options{}, true, true},
+	{"admin-*", "*", Options{}, false, true},
+	{"admin-*", "admin-root", Options{}, true, true},
+	{"admin-*", "user-*", Options{}, false, false},
+	{"*-admin", "user-*", Options{}, false, true},
+	{"a*", "a", Options{}, true, true},
+	{"a", "a", Options{}, true, true},
+	{"a", "b", Options{}, false, false},
+	{"", "", Options{}, true, true},
+	{"*", "", Options{}, true, true},
+	{"?", "", Options{}, true, true},
+	{"a*b*c", "a*bc", Options{}, true, true},
+	{"a*bc", "a*b*c", Options{}, false, true},
+
+	// Question marks, dots and classes
+	{"??", "?", Options{}, true, true},
+	{"?", "??", Options{}, false, true},
+	{"?", "??", Options{StrictQuestion: true}, false, false},
+	{"*", "file.txt", Options{}, true, true},
+	{"file?txt", "file.txt", Options{StrictQuestion: true, LiteralDot: true}, true, true},
+	{"file.txt", "file?txt", Options{StrictQuestion: true, LiteralDot: true}, false, true},
+	{".", "\n", Options{}, false, false},
+	{"?", "\n", Options{}, true, true},
+	{"[a-z]", "[b-d]", Options{}, true, true},
+	{"[a-c]", "[b-d]", Options{}, false, true},
+	{"[!a]", "[b-z]", Options{}, true, true},
+	{"[!a-z]", "[a-z]", Options{}, false, false},
+	{"[[:alpha:]]", "\\p{L}", Options{}, true, true},
+	{"\\p{L}", "[[:alpha:]]", Options{}, true, true},
+	{"[[:digit:]]", "[0-9]", Options{}, true, true},
+	{"\\p{Lu}", "\\p{Ll}", Options{}, false, false},
+	{"\\P{Lu}", "[a-z]", Options{}, true, true},
+	{"\\*", "\\*", Options{}, true, true},
+	{"\\*", "a", Options{}, false, false},
+
+	// Brace groups
+	{"{a,b}*", "a*", Options{}, true, true},
+	{"*.{jpg,png}", "*.png", Options{LiteralDot: true}, true, true},
+	{"*.png", "*.{jpg,png}", Options{LiteralDot: true}, false, true},
+	{"{a,}{b,}", "{ab,a,b,}", Options{}, true, true},
+	{"{ab,a,b,}", "{a,}{b,}", Options{}, true, true},
+
+	// Separators and globstars
+	{"src/**", "src/*/*.go", Options{Separator: '/'}, true, true},
+	{"src/*", "src/*/*.go", Options{Separator: '/'}, false, false},
+	{"src/**/*.go", "src/*.go", Options{Separator: '/'}, true, true},
+	{"src/*.go", "src/**/*.go", Options{Separator: '/'}, false, true},
+	{"**/b", "a/**/b", Options{Separator: '/'}, true, true},
+	{"*", "a/b", Options{Separator: '/'}, false, false},
+	{"?", "/", Options{Separator: '/'}, false, false},
+	{"[!a]", "/", Options{Separator: '/'}, false, false},
+}
+
+// TestAnalysis validates Subsumes, Overlaps and Equivalent on known cases
+func TestAnalysis(t *testing.T) {
+	for _, tc := range analysisCases {
+		a, err := CompileFold(tc.a, false, tc.opts)
+		if err != nil {
+			t.Fatalf("CompileFold(%q): %v", tc.a, err)
+		}
+		b, err := CompileFold(tc.b, false, tc.opts)
+		if err != nil {
+			t.Fatalf("CompileFold(%q): %v", tc.b, err)
+		}
+
+		if got, err := Subsumes(a, b); err != nil || got != tc.subsumes {
+			t.Errorf("Subsumes(%q, %q, %+v) = %v, %v; expected %v", tc.a, tc.b, tc.opts, got, err, tc.subsumes)
+		}
+		witness, got, err := Overlaps(a, b)
+		if err != nil || got != tc.overlaps {
+			t.Errorf("Overlaps(%q, %q, %+v) = %v, %v; expected %v", tc.a, tc.b, tc.opts, got, err, tc.overlaps)
+		}
+		if got && (!MatchProgram(a, witness) || !MatchProgram(b, witness)) {
+			t.Errorf("Overlaps(%q, %q, %+v) returned %q, which they do not both match", tc.a, tc.b, tc.opts, witness)
+		}
+		reverse, _ := Subsumes(b, a)
+		if got, err := Equivalent(a, b); err != nil || got != (tc.subsumes && reverse) {
+			t.Errorf("Equivalent(%q, %q, %+v) = %v, %v; expected %v", tc.a, tc.b, tc.opts, got, err, tc.subsumes && reverse)
+		}
+	}
+}
+
+// TestAnalysisFold validates the analysis of case-insensitive programs
+func TestAnalysisFold(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		opts     Options
+		subsumes bool
+	}{
+		{"ADMIN-*", "admin-*", Options{}, true},
+		{"[a-z]*", "A*", Options{}, false},
+		{"[a-z]*", "A*", Options{FoldClasses: true}, true},
+		{"k", "K", Options{}, true}, // KELVIN SIGN
+		{"i", "I", Options{}, true},
+		{"i", "I", Options{Turkic: true}, false},
+		{"i", "İ", Options{Turkic: true}, true},
+		{"[i]", "İ", Options{Turkic: true, FoldClasses: true}, true},
+	}
+	for _, tc := range tests {
+		a, _ := CompileFold(tc.a, true, tc.opts)
+		b, _ := CompileFold(tc.b, true, tc.opts)
+		if got, err := Subsumes(a, b); err != nil || got != tc.subsumes {
+			t.Errorf("Subsumes(%q, %q, %+v) = %v, %v; expected %v", tc.a, tc.b, tc.opts, got, err, tc.subsumes)
+		}
+	}
+}
+
+// TestAnalysisUnsupported validates that options changing what a character is
+// are refused
+func TestAnalysisUnsupported(t *testing.T) {
+	plain, _ := CompileFold("a*", false, Options{})
+	byteProg, _ := Compile("a*", Options{})
+	for _, prog := range []*Program{
+		byteProg,
+		mustCompileFold(t, "a*", Options{FullFold: true}),
+		mustCompileFold(t, "a*", Options{IgnoreAccents: true}),
+		mustCompileFold(t, "a*", Options{Graphemes: true}),
+		mustCompileFold(t, "a*", Options{Normalize: true}),
+		mustCompileFold(t, "a*", Options{InvalidUTF8: UTF8Opaque}),
+	} {
+		if _, err := Subsumes(plain, prog); !errors.Is(err, errors.ErrUnsupported) {
+			t.Errorf("Subsumes with %+v: expected ErrUnsupported, got %v", prog, err)
+		}
+		if _, _, err := Overlaps(prog, plain); !errors.Is(err, errors.ErrUnsupported) {
+			t.Errorf("Overlaps with %+v: expected ErrUnsupported, got %v", prog, err)
+		}
+	}
+}
+
+// TestAnalysisTooComplex validates that patterns whose comparison takes
+// exponential time are reported as ErrTooComplex within the state limit
+func TestAnalysisTooComplex(t *testing.T) {
+	dots := strings.Repeat(".", 16)
+	a, b := mustCompileFold(t, "*a"+dots, Options{}), mustCompileFold(t, "*b"+dots, Options{})
+	start := time.Now()
+	if _, err := Equivalent(a, b); !errors.Is(err, ErrTooComplex) {
+		t.Errorf("Equivalent: expected ErrTooComplex, got %v", err)
+	}
+	if _, err := Subsumes(a, b); !errors.Is(err, ErrTooComplex) {
+		t.Errorf("Subsumes: expected ErrTooComplex, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("Expected the state limit to stop the analysis, took %v", elapsed)
+	}
+
+	// The limit is not reached by patterns with shorter runs
+	a, b = mustCompileFold(t, "*a...", Options{}), mustCompileFold(t, "*b...", Options{})
+	if got, err := Equivalent(a, b); err != nil || got {
+		t.Errorf("Equivalent: expected false, got %v, %v", got, err)
+	}
+}
+
+// mustCompileFold compiles a case-insensitive program or fails the test.
+func mustCompileFold(t *testing.T, pattern string, opts Options) *Program {
+	t.Helper()
+	prog, err := CompileFold(pattern, true, opts)
+	if err != nil {
+		t.Fatalf("CompileFold(%q, %+v): %v", pattern, opts, err)
+	}
+	return prog
+}
+
+// TestAnalysisDifferential validates the analysis of random patterns against
+// matching every short string over a small alphabet
+func TestAnalysisDifferential(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	inputs := shortInputs(3)
+	for i := 0; i < 300; i++ {
+		pa, pb := randomPattern(rng, 4), randomPattern(rng, 4)
+		opts := randomOpts(rng)
+		fold := rng.Intn(2) == 0
+		a, err := CompileFold(pa, fold, opts)
+		if err != nil {
+			t.Fatalf("CompileFold(%q): %v", pa, err)
+		}
+		b, err := CompileFold(pb, fold, opts)
+		if err != nil {
+			t.Fatalf("CompileFold(%q): %v", pb, err)
+		}
+
+		common, onlyA := false, false
+		for _, s := range inputs {
+			ma, mb := MatchProgram(a, s), MatchProgram(b, s)
+			common = common || ma && mb
+			onlyA = onlyA || ma && !mb
+		}
+
+		witness, overlaps, err := Overlaps(a, b)
+		switch {
+		case err != nil:
+			t.Fatalf("Overlaps(%q, %q): %v", pa, pb, err)
+		case overlaps && (!MatchProgram(a, witness) || !MatchProgram(b, witness)):
+			t.Errorf("Overlaps(%q, %q, %v, %+v) returned %q, which they do not both match", pa, pb, fold, opts, witness)
+		case common && !overlaps:
+			t.Errorf("Overlaps(%q, %q, %v, %+v) found no common string", pa, pb, fold, opts)
+		}
+
+		witness, different, err := Difference(a, b)
+		switch {
+		case err != nil:
+			t.Fatalf("Difference(%q, %q): %v", pa, pb, err)
+		case different && (!MatchProgram(a, witness) || MatchProgram(b, witness)):
+			t.Errorf("Difference(%q, %q, %v, %+v) returned %q, which is not matched by the first only", pa, pb, fold, opts, witness)
+		case onlyA && !different:
+			t.Errorf("Difference(%q, %q, %v, %+v) found no difference", pa, pb, fold, opts)
+		}
+	}
+}
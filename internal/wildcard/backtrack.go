@@ -0,0 +1,224 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+// Package wildcard contains optimized wildcard matching implementations.
+// This file provides the save points of the backtracking loops in match.go and
+// match_fold.go, kept in fixed-size arrays so that matching does not allocate.
+package wildcard
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxSavePoints bounds the runs of optional `?` the backtracking loops keep track
+// of at once, and the stars whose failures they remember. Patterns needing more
+// are matched by the compiled engine instead.
+const maxSavePoints = 8
+
+// starPoint is the save point of the last star run reached, retried one input
+// position later on a mismatch. Earlier stars never need to be retried: any
+// match found through them can be found through the last one.
+type starPoint struct {
+	run   int // Pattern index of the star run, -1 before the first star
+	p     int // Pattern index after the run
+	lit   int // End of the literal following the run, p without one
+	start int // Input index at which the run was reached
+	s     int // Input index at which the rest of the pattern is being tried
+	end   int // Input index from which the rest is known to fail
+}
+
+// questionPoint is the save point of a run of optional `?`, which may consume
+// one more character while left is positive. It records the star it follows.
+type questionPoint struct {
+	p, s, left int
+	star       starPoint
+}
+
+// starFailure records that the rest of the pattern after the star run at
+// pattern index run fails for every input index from s on.
+type starFailure struct {
+	run, s int
+}
+
+// backtracker holds the save points of a backtracking loop. Unlike a star, a
+// run of optional `?` is a choice that a later star does not make up for, so
+// each run is kept until every choice made after it has failed. Stars whose
+// rest fails while such runs remain are remembered, so that no input index is
+// tried twice after the same star.
+type backtracker struct {
+	isString bool
+	pStr     string
+	sStr     string
+	pBytes   []byte
+	sBytes   []byte
+	sLen     int
+	runes    bool // `?` consumes a UTF-8 sequence rather than a byte
+
+	star      starPoint
+	questions [maxSavePoints]questionPoint
+	failures  [maxSavePoints]starFailure
+	nq, nf    int
+	overflow  bool // A bound was exceeded, the loop must defer to the compiled engine
+}
+
+// init sets up the backtracker for pattern and s, given as strings or byte
+// slices according to isString.
+func (b *backtracker) init(isString bool, pStr, sStr string, pBytes, sBytes []byte, runes bool) {
+	b.isString, b.pStr, b.sStr, b.pBytes, b.sBytes, b.runes = isString, pStr, sStr, pBytes, sBytes, runes
+	if isString {
+		b.sLen = len(sStr)
+	} else {
+		b.sLen = len(sBytes)
+	}
+	b.star.run = -1
+}
+
+// width returns the number of bytes a `?` consumes at input index i.
+func (b *backtracker) width(i int) int {
+	if !b.runes {
+		return 1
+	}
+	if b.isString {
+		_, w := utf8.DecodeRuneInString(b.sStr[i:])
+		return w
+	}
+	_, w := utf8.DecodeRune(b.sBytes[i:])
+	return w
+}
+
+// enterStar saves the star run from pattern index run to p, reached at input
+// index s. lit is the end of the literal following the run, p without one. It
+// returns false if the rest of the pattern is known to fail from s.
+func (b *backtracker) enterStar(run, p, lit, s int) bool {
+	end := b.sLen + 1
+	for _, f := range b.failures[:b.nf] {
+		if f.run == run {
+			end = f.s
+			break
+		}
+	}
+	if s >= end {
+		return false
+	}
+	b.star = starPoint{run: run, p: p, lit: lit, start: s, s: s, end: end}
+	return true
+}
+
+// enterQuestion saves the run of count optional `?` ending at pattern index p,
+// reached at input index s, which first consumes nothing. It returns false if
+// there is no room left for the run.
+func (b *backtracker) enterQuestion(p, s, count int) bool {
+	if b.nq == len(b.questions) {
+		b.overflow = true
+		return false
+	}
+	b.questions[b.nq] = questionPoint{p: p, s: s, left: count, star: b.star}
+	b.nq++
+	return true
+}
+
+// next returns the pattern and input indexes from which to resume after a
+// mismatch, retrying the most recent choice that has any left. It returns false
+// when there is none, or when a bound was exceeded.
+func (b *backtracker) next() (int, int, bool) {
+	for !b.overflow {
+		// A run of `?` saved after the current star is the most recent choice
+		if b.nq > 0 && b.questions[b.nq-1].p > b.star.p {
+			q := &b.questions[b.nq-1]
+			if q.left > 0 && q.s < b.sLen {
+				q.s += b.width(q.s)
+				q.left--
+				return q.p, q.s, true
+			}
+			b.nq--
+			continue
+		}
+
+		if b.star.run < 0 {
+			return 0, 0, false
+		}
+		if s, ok := b.advanceStar(); ok {
+			b.star.s = s
+			return b.star.p, s, true
+		}
+
+		// The star is exhausted: nothing is left to retry unless a run of `?`
+		// before it can make the star start elsewhere
+		if b.nq == 0 {
+			return 0, 0, false
+		}
+		b.fail(b.star.run, b.star.start)
+		b.star = b.questions[b.nq-1].star
+	}
+	return 0, 0, false
+}
+
+// fail records that the rest of the pattern after the star run at pattern index
+// run fails from input index s on.
+func (b *backtracker) fail(run, s int) {
+	for i := range b.failures[:b.nf] {
+		if b.failures[i].run == run {
+			b.failures[i].s = s
+			return
+		}
+	}
+	if b.nf == len(b.failures) {
+		b.overflow = true
+		return
+	}
+	b.failures[b.nf] = starFailure{run: run, s: s}
+	b.nf++
+}
+
+// advanceStar returns the next input index at which to try the rest of the
+// pattern after the current star, skipping to the next occurrence of the literal
+// following the run if there is one.
+func (b *backtracker) advanceStar() (int, bool) {
+	st := &b.star
+	if st.s >= b.sLen {
+		return 0, false
+	}
+	s := st.s + 1
+	if st.lit > st.p {
+		var i int
+		if b.isString {
+			i = strings.Index(b.sStr[s:], b.pStr[st.p:st.lit])
+		} else {
+			i = bytes.Index(b.sBytes[s:], b.pBytes[st.p:st.lit])
+		}
+		if i < 0 {
+			return 0, false
+		}
+		s += i
+	}
+	return s, s < st.end
+}
+
+// backtrackFailed returns the result of a backtracking loop left without a save
+// point to retry: no match, unless a bound was exceeded, in which case pattern is
+// matched by the compiled engine instead.
+func backtrackFailed[T ~string | ~[]byte](b *backtracker, pattern, s T, fold bool, opts Options) (bool, error) {
+	if !b.overflow {
+		return false, nil
+	}
+	var prog *Program
+	var err error
+	if b.runes {
+		prog, err = CompileFold(pattern, fold, opts)
+	} else {
+		prog, err = Compile(pattern, opts)
+	}
+	if err != nil {
+		return false, err
+	}
+	return matchCompiled(prog, s)
+}
@@ -10,6 +10,7 @@ Neither the name of the copyright holder nor the names of its contributors may b
 package wildcard
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -345,3 +346,143 @@ func TestCharClassConsistency(t *testing.T) {
 		})
 	}
 }
+
+// Test POSIX named classes with their ASCII definitions in the byte-oriented engine
+// and their Unicode definitions in the Unicode engine
+func TestPosixCharClass(t *testing.T) {
+	tests := []struct {
+		class   string
+		input   rune
+		ascii   bool // Expected result of charClass, for ASCII input only
+		unicode bool // Expected result of charClassFold
+	}{
+		{"[[:alpha:]]", 'a', true, true},
+		{"[[:alpha:]]", 'Z', true, true},
+		{"[[:alpha:]]", '1', false, false},
+		{"[[:alpha:]]", 'é', false, true},
+		{"[[:alpha:]]", '中', false, true},
+		{"[[:digit:]]", '7', true, true},
+		{"[[:digit:]]", '٣', false, true}, // ARABIC-INDIC DIGIT THREE
+		{"[[:digit:]]", 'x', false, false},
+		{"[[:alnum:]]", '7', true, true},
+		{"[[:alnum:]]", '_', false, false},
+		{"[[:upper:]]", 'A', true, true},
+		{"[[:upper:]]", 'a', false, false},
+		{"[[:upper:]]", 'É', false, true},
+		{"[[:lower:]]", 'a', true, true},
+		{"[[:lower:]]", 'ß', false, true},
+		{"[[:space:]]", ' ', true, true},
+		{"[[:space:]]", '\n', true, true},
+		{"[[:space:]]", ' ', false, true}, // NO-BREAK SPACE
+		{"[[:blank:]]", '\t', true, true},
+		{"[[:blank:]]", '\n', false, false},
+		{"[[:blank:]]", '　', false, true}, // IDEOGRAPHIC SPACE
+		{"[[:cntrl:]]", '\x7f', true, true},
+		{"[[:cntrl:]]", 'a', false, false},
+		{"[[:punct:]]", '!', true, true},
+		{"[[:punct:]]", '$', true, true},
+		{"[[:punct:]]", '«', false, true},
+		{"[[:punct:]]", 'a', false, false},
+		{"[[:graph:]]", '~', true, true},
+		{"[[:graph:]]", ' ', false, false},
+		{"[[:print:]]", ' ', true, true},
+		{"[[:print:]]", '\t', false, false},
+		{"[[:xdigit:]]", 'f', true, true},
+		{"[[:xdigit:]]", 'G', false, false},
+
+		// Named classes combine with other members and negation
+		{"[[:digit:]_]", '_', true, true},
+		{"[[:digit:]a-c]", 'b', true, true},
+		{"[![:space:]]", 'x', true, true},
+		{"[![:space:]]", ' ', false, false},
+		{"[^[:alpha:][:digit:]]", '-', true, true},
+		{"[^[:alpha:][:digit:]]", 'q', false, false},
+
+		// `[` without a complete name is an ordinary member
+		{"[[]", '[', true, true},
+		{"[[:]", ':', true, true},
+		{"[[:alpha]", 'a', true, true},
+		{"[\\[:alpha:]]", ':', true, true}, // An escaped `[` never starts a name
+	}
+
+	for _, tt := range tests {
+		if tt.input < 0x80 {
+			cc, _, err := NewCharClass(tt.class, 0)
+			if err != nil {
+				t.Fatalf("NewCharClass(%q) failed: %v", tt.class, err)
+			}
+			if got := cc.matches(byte(tt.input)); got != tt.ascii {
+				t.Errorf("NewCharClass(%q) on %q: expected %v, got %v", tt.class, tt.input, tt.ascii, got)
+			}
+		}
+
+		cc, _, err := NewcharClassFold(tt.class, 0)
+		if err != nil {
+			t.Fatalf("NewcharClassFold(%q) failed: %v", tt.class, err)
+		}
+		if got := cc.MatchesWithFold(tt.input, true); got != tt.unicode {
+			t.Errorf("NewcharClassFold(%q) on %q: expected %v, got %v", tt.class, tt.input, tt.unicode, got)
+		}
+	}
+
+	// Non-ASCII bytes never belong to an ASCII named class
+	cc, _, _ := NewCharClass("[[:alpha:]]", 0)
+	if cc.matches(0xe9) {
+		t.Errorf("Expected byte 0xe9 not to match [[:alpha:]]")
+	}
+}
+
+// Test that unknown or unterminated named classes are rejected
+func TestPosixCharClassErrors(t *testing.T) {
+	tests := []struct {
+		pattern string
+		offset  int
+		reason  string
+	}{
+		{"x[[:foo:]]", 2, ReasonUnknownClass},
+		{"[a[:digits:]]", 2, ReasonUnknownClass},
+		{"[[:alpha:]", 0, ReasonUnclosedClass},
+	}
+
+	for _, tt := range tests {
+		for _, unicode := range []bool{false, true} {
+			err := validate(tt.pattern, unicode)
+			var pe *PatternError
+			if !errors.As(err, &pe) || pe.Offset != tt.offset || pe.Reason != tt.reason {
+				t.Errorf("validate(%q, %v): expected %s at offset %d, got %v", tt.pattern, unicode, tt.reason, tt.offset, err)
+			}
+		}
+	}
+}
+
+// Test case-insensitive classes, which apply simple folding to the input rune
+// instead of expanding ranges
+func TestCharClassFolded(t *testing.T) {
+	tests := []struct {
+		class string
+		input rune
+		want  bool
+	}{
+		{"[a-z]", 'A', true},
+		{"[a-z]", 'K', true}, // KELVIN SIGN folds to 'k'
+		{"[A-Z]", 'k', true},
+		{"[a-z]", '1', false},
+		{"[é]", 'É', true},
+		{"[α-ω]", 'Σ', true},
+		{"[σ]", 'ς', true}, // Final sigma is in the same orbit
+		{"[!a-z]", 'A', false},
+		{"[!a-z]", '1', true},
+		{"[[:lower:]]", 'Q', true},
+		{"[\\p{Lu}]", 'q', true},
+	}
+
+	for _, tt := range tests {
+		cc, _, err := NewcharClassFold(tt.class, 0)
+		if err != nil {
+			t.Fatalf("NewcharClassFold(%q) failed: %v", tt.class, err)
+		}
+		if got := cc.matchesFolded(tt.input, false); got != tt.want {
+			t.Errorf("matchesFolded(%q, %q): expected %v, got %v", tt.class, tt.input, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,401 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+// Package wildcard contains optimized wildcard matching implementations.
+// This file provides the pattern compiler, which parses a pattern once into a
+// flat instruction list that can be matched repeatedly without re-scanning
+// the pattern or re-parsing character classes.
+// The instruction list is executed by the matcher in exec.go.
+package wildcard
+
+import (
+	"bytes"
+	"unicode/utf8"
+)
+
+// opcode identifies the kind of a compiled instruction.
+type opcode uint8
+
+const (
+	opLiteral  opcode = iota // Run of literal characters
+	opStar                   // `*`: any sequence of characters
+	opQuestion               // Run of `?`: zero up to n characters
+	opDot                    // `.`: any single character except newline
+	opClass                  // `[...]`: a single character from a class
+	opGlobstar               // `**` path segment: zero or more whole segments
+	opAlt                    // `{`: try each alternative of a brace group
+	opJump                   // End of a brace alternative: continue after the group
+	opAny                    // LIKE `_`: any single character, including newline
+)
+
+// instr is a single instruction of a compiled Program.
+type instr struct {
+	op        opcode
+	lit       string         // opLiteral: unescaped literal text
+	litBytes  []byte         // opLiteral: lit as bytes, for bytes.Index
+	n         int            // opQuestion: number of `?` in the run
+	min       int            // opStar, opQuestion: number of characters required
+	slot      int            // Index into the matcher's memo, for memoized instructions
+	dirs      bool           // opGlobstar: the trailing separator was absorbed
+	alts      []int          // opAlt: first instruction of each alternative
+	jump      int            // opAlt, opJump: instruction following the brace group
+	capture   int            // Capture index of a wildcard instruction
+	depth     int            // Brace nesting depth of the instruction
+	class     *charClass     // opClass in a byte-oriented program
+	classFold *charClassFold // opClass in a Unicode program
+}
+
+// Program is a wildcard pattern parsed once into a flat instruction list.
+//
+// Literals, `.` and character classes are matched inline by the matcher; `*`,
+// `**`, runs of `?` and brace groups are the only backtracking points.
+// Consecutive `*` and `?` wildcards containing at least one `*` collapse into a
+// single star, which is how MatchInternal absorbs `?` into `*` at run time.
+//
+// A brace group `{a,b}` compiles to an opAlt listing where each alternative
+// starts, with every alternative but the last ending in an opJump past the group.
+// All jumps point forward, so the alternatives share the instructions that follow
+// the group and matching never expands the group into separate patterns.
+//
+// Every wildcard instruction (`*`, `**`, a run of `?`, `.` and a class) is a
+// capture, numbered in pattern order, so a successful match can report the
+// span of input consumed by each of them. Under StrictQuestion a run of `?`
+// compiles to one instruction per `?`, each consuming exactly one character.
+//
+// A Program is immutable once compiled and safe for concurrent use.
+type Program struct {
+	insts         []instr
+	unicode       bool       // Rune-oriented matching (MatchInternalFold semantics)
+	fold          bool       // Case-insensitive matching using Unicode simple folding
+	foldClasses   bool       // Character classes are case-insensitive too
+	fullFold      bool       // Literals use full case folding, see fullfold.go
+	turkic        bool       // Folding follows the Turkic casing of I, see turkic.go
+	ignoreAccents bool       // Accents and combining marks are ignored, see accent.go
+	graphemes     bool       // Wildcards consume grapheme clusters, see grapheme.go
+	normalize     bool       // Canonically equivalent text is equal, see normalize.go
+	invalid       UTF8Policy // Handling of invalid UTF-8 in Unicode programs, see utf8.go
+	slots         int        // Number of memoized instructions
+	captures      int        // Number of capturing instructions
+	sep           rune       // Path separator, 0 if path-aware matching is disabled
+}
+
+// Compile parses pattern into a Program with the byte-oriented, case-sensitive
+// semantics of MatchInternal, adjusted by opts. Every character class is validated
+// up front, so a malformed pattern is reported regardless of the input it would be
+// matched against. Property escapes and options that need rune decoding, such as
+// a non-ASCII separator, make the program Unicode-aware.
+func Compile[T ~string | ~[]byte](pattern T, opts Options) (*Program, error) {
+	return compile(pattern, opts.unicode() || hasProperty(pattern), false, opts)
+}
+
+// CompileFold parses pattern into a Program with the Unicode-aware semantics of
+// MatchInternalFold, adjusted by opts. The fold parameter selects case-insensitive
+// matching using Unicode simple folding; character classes remain case-sensitive
+// unless opts.FoldClasses is set.
+func CompileFold[T ~string | ~[]byte](pattern T, fold bool, opts Options) (*Program, error) {
+	return compile(pattern, true, fold, opts)
+}
+
+// compile is the shared parser behind Compile and CompileFold.
+func compile[T ~string | ~[]byte](pattern T, unicode, fold bool, opts Options) (*Program, error) {
+	prog := &Program{
+		unicode:       unicode,
+		fold:          fold,
+		foldClasses:   fold && opts.FoldClasses,
+		fullFold:      fold && opts.FullFold,
+		turkic:        fold && opts.Turkic,
+		ignoreAccents: opts.IgnoreAccents,
+		graphemes:     opts.Graphemes,
+		normalize:     opts.Normalize,
+		sep:           opts.Separator,
+	}
+	if unicode {
+		// Invalid UTF-8 only matters when the input is decoded
+		prog.invalid = opts.InvalidUTF8
+		if err := checkUTF8(pattern, prog.invalid); err != nil {
+			return nil, err
+		}
+	}
+	pLen := len(pattern)
+
+	// The separator as it appears in the pattern, used to recognize `**` segments
+	var sep []byte
+	if prog.sep != 0 {
+		sep = utf8.AppendRune(nil, prog.sep)
+	}
+
+	// Open brace groups, innermost last
+	type group struct {
+		alt      int   // Index of the opAlt instruction
+		offset   int   // Offset of the opening brace in the pattern
+		jumps    []int // Indexes of the opJump instructions to patch
+		segStart bool  // Whether the group starts a path segment
+	}
+	var groups []group
+
+	// emit appends an instruction at the current brace depth
+	emit := func(in instr) {
+		in.depth = len(groups)
+		prog.add(in)
+	}
+
+	// Literal characters are accumulated and emitted as a single instruction
+	var lit []byte
+	flush := func() {
+		if len(lit) > 0 {
+			emit(instr{op: opLiteral, lit: string(lit), litBytes: lit})
+			lit = nil
+		}
+	}
+
+	// segStart reports whether the next token starts a path segment
+	segStart := true
+
+	for pIdx := 0; pIdx < pLen; {
+		switch c := pattern[pIdx]; {
+		case c == wildcardStar || c == wildcardQuestion:
+			// Collect the whole run of `*` and `?` wildcards
+			end, stars := pIdx, 0
+			for end < pLen && (pattern[end] == wildcardStar || pattern[end] == wildcardQuestion) {
+				if pattern[end] == wildcardStar {
+					stars++
+				}
+				end++
+			}
+			// With a separator, `**` forming a whole path segment is a globstar
+			if sep != nil && stars == end-pIdx && stars > 1 && segStart {
+				if end == pLen && len(groups) == 0 {
+					flush()
+					emit(instr{op: opGlobstar, slot: prog.slots})
+					prog.slots++
+					pIdx = end
+					continue
+				}
+				if hasPrefixAt(pattern, end, sep) {
+					// The globstar absorbs its trailing separator so that it can
+					// also match zero segments, as in `a/**/b` against `a/b`
+					flush()
+					emit(instr{op: opGlobstar, dirs: true, slot: prog.slots})
+					prog.slots++
+					pIdx = end + len(sep)
+					continue
+				}
+			}
+
+			flush()
+			switch {
+			case stars > 0:
+				// `*` absorbs any `?` in the same run, which still require a
+				// character each under strict semantics
+				in := prog.star()
+				if opts.StrictQuestion {
+					in.min = end - pIdx - stars
+				}
+				emit(in)
+			case opts.StrictQuestion:
+				// Each strict `?` consumes exactly one character, so each is a
+				// capture of its own
+				for range end - pIdx {
+					emit(instr{op: opQuestion, n: 1, min: 1, slot: prog.slots})
+					prog.slots++
+				}
+			default:
+				emit(instr{op: opQuestion, n: end - pIdx, slot: prog.slots})
+				prog.slots++
+			}
+			segStart = false
+			pIdx = end
+
+		case c == wildcardDot && !opts.LiteralDot:
+			flush()
+			emit(instr{op: opDot})
+			segStart = false
+			pIdx++
+
+		case c == wildcardBracket:
+			flush()
+			in := instr{op: opClass}
+			var newPIdx int
+			var err error
+			if unicode {
+				in.classFold, newPIdx, err = parseCharClassFold(pattern, pIdx, prog.opaque())
+			} else {
+				in.class, newPIdx, err = NewCharClass(pattern, pIdx)
+			}
+			if err != nil {
+				return nil, err
+			}
+			emit(in)
+			segStart = false
+			pIdx = newPIdx
+
+		case c == wildcardBrace:
+			// The first alternative starts right after the opAlt instruction
+			flush()
+			groups = append(groups, group{alt: len(prog.insts), offset: pIdx, segStart: segStart})
+			emit(instr{op: opAlt, alts: []int{len(prog.insts) + 1}, slot: prog.slots})
+			prog.slots++
+			pIdx++
+
+		case c == braceComma && len(groups) > 0:
+			flush()
+			g := &groups[len(groups)-1]
+			g.jumps = append(g.jumps, len(prog.insts))
+			emit(instr{op: opJump})
+			alt := &prog.insts[g.alt]
+			alt.alts = append(alt.alts, len(prog.insts))
+			segStart = g.segStart
+			pIdx++
+
+		case c == braceClose && len(groups) > 0:
+			// Every alternative continues with the instruction after the group
+			flush()
+			g := groups[len(groups)-1]
+			for _, j := range g.jumps {
+				prog.insts[j].jump = len(prog.insts)
+			}
+			prog.insts[g.alt].jump = len(prog.insts)
+			groups = groups[:len(groups)-1]
+			segStart = false
+			pIdx++
+
+		case c == wildcardEscape:
+			prop, end, err := parseProperty(pattern, pIdx)
+			if err != nil {
+				return nil, err
+			}
+			if prop != nil {
+				// A property escape is a class of its own; the program is
+				// Unicode-aware whenever the pattern contains one
+				flush()
+				emit(instr{op: opClass, classFold: &charClassFold{Props: []*property{prop}}})
+				segStart = false
+				pIdx = end
+				continue
+			}
+			if pIdx+1 >= pLen {
+				// Trailing backslash matches a literal backslash
+				lit = append(lit, wildcardEscape)
+				segStart = false
+				pIdx++
+				continue
+			}
+			width := 1
+			if unicode {
+				// The escaped character is a whole rune, not just its first byte
+				_, width = decodeRune(pattern, pIdx+1)
+			}
+			lit = append(lit, pattern[pIdx+1:pIdx+1+width]...)
+			segStart = sep != nil && bytes.HasSuffix(lit, sep)
+			pIdx += 1 + width
+
+		default:
+			lit = append(lit, c)
+			segStart = sep != nil && bytes.HasSuffix(lit, sep)
+			pIdx++
+		}
+	}
+	flush()
+
+	// An unclosed brace group is malformed, like an unclosed character class
+	if len(groups) > 0 {
+		offset := groups[len(groups)-1].offset
+		return nil, newPatternError(pattern, offset, offset+1, ReasonUnclosedBrace)
+	}
+
+	return prog, nil
+}
+
+// add appends in to the program, numbering the capture of wildcard instructions
+// and removing the accents of literals when accents are ignored. Under
+// normalization, literals keep their accents: they are removed as literal and
+// input are canonicalized, by the same appendCanonical.
+func (p *Program) add(in instr) {
+	switch in.op {
+	case opLiteral:
+		if p.ignoreAccents && !p.normalize {
+			in.lit = stripAccents(in.lit, p.opaque())
+			in.litBytes = []byte(in.lit)
+		}
+	case opStar, opQuestion, opDot, opClass, opGlobstar, opAny:
+		in.capture = p.captures
+		p.captures++
+	}
+	p.insts = append(p.insts, in)
+}
+
+// exactLiterals reports whether literals only match their own bytes, so that
+// they can be searched for directly in the input.
+func (p *Program) exactLiterals() bool {
+	return !p.fold && !p.ignoreAccents && !p.normalize
+}
+
+// opaque reports whether invalid bytes are characters of their own.
+func (p *Program) opaque() bool {
+	return p.invalid == UTF8Opaque
+}
+
+// searchLiterals reports whether every occurrence of a literal in the input is a
+// place where it can match, so that a star can jump straight to them. In
+// grapheme-aware programs an occurrence can start inside a cluster.
+func (p *Program) searchLiterals() bool {
+	return p.exactLiterals() && !p.graphemes
+}
+
+// equalRune reports whether a literal rune matches an input rune, with the
+// program's case folding.
+func (p *Program) equalRune(r1, r2 rune) bool {
+	switch {
+	case !p.fold:
+		return r1 == r2
+	case p.turkic:
+		return equalFoldTurkic(r1, r2)
+	default:
+		return equalFoldRune(r1, r2)
+	}
+}
+
+// star returns a `*` instruction. Stars bounded by separators are memoized per
+// position instead of using the star fail bound, so they need a slot.
+func (p *Program) star() instr {
+	if p.sep == 0 {
+		return instr{op: opStar}
+	}
+	p.slots++
+	return instr{op: opStar, slot: p.slots - 1}
+}
+
+// NumCaptures returns the number of capturing wildcard instructions in p.
+func (p *Program) NumCaptures() int {
+	return p.captures
+}
+
+// hasPrefixAt reports whether pattern[i:] begins with prefix.
+func hasPrefixAt[T ~string | ~[]byte](pattern T, i int, prefix []byte) bool {
+	if len(pattern)-i < len(prefix) {
+		return false
+	}
+	for j, c := range prefix {
+		if pattern[i+j] != c {
+			return false
+		}
+	}
+	return true
+}
+
+// decodeRune decodes the rune starting at s[i] for either input type.
+// Converting at most utf8.UTFMax bytes keeps the []byte instantiation free of
+// heap allocations.
+func decodeRune[T ~string | ~[]byte](s T, i int) (rune, int) {
+	if c := s[i]; c < utf8.RuneSelf {
+		return rune(c), 1
+	}
+	return utf8.DecodeRuneInString(string(s[i:min(i+utf8.UTFMax, len(s))]))
+}
@@ -0,0 +1,223 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+package wildcard
+
+import (
+	"errors"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// TestCompileMatch validates compiled programs against baseTestCases for string and []byte input
+func TestCompileMatch(t *testing.T) {
+	for i, c := range baseTestCases {
+		prog, err := Compile(c.pattern, Options{})
+		if err != nil {
+			t.Errorf("Test %d: Unexpected error: %v; With Pattern: `%s`", i+1, err, c.pattern)
+			continue
+		}
+		if result := MatchProgram(prog, c.s); result != c.result {
+			t.Errorf("Test %d: Expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+		if result := MatchProgram(prog, []byte(c.s)); result != c.result {
+			t.Errorf("Test %d (bytes): Expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+	}
+}
+
+// TestCompileFoldMatch validates compiled Unicode programs against baseTestCases and caseFoldCases
+func TestCompileFoldMatch(t *testing.T) {
+	cases := append(baseTestCases[:len(baseTestCases):len(baseTestCases)], caseFoldCases...)
+	for i, c := range cases {
+		prog, err := CompileFold(c.pattern, true, Options{})
+		if err != nil {
+			t.Errorf("Test %d: Unexpected error: %v; With Pattern: `%s`", i+1, err, c.pattern)
+			continue
+		}
+		if result := MatchProgram(prog, c.s); result != c.result {
+			t.Errorf("Test %d: Expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+		if result := MatchProgram(prog, []byte(c.s)); result != c.result {
+			t.Errorf("Test %d (bytes): Expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+	}
+}
+
+// TestCompileErrors validates that malformed patterns are rejected at compile time
+func TestCompileErrors(t *testing.T) {
+	patterns := []string{"[z-a]", "abc[", "*[!", "a[b-", "[\\", "a{b", "{a,{b}"}
+
+	for _, pattern := range patterns {
+		if _, err := Compile(pattern, Options{}); !errors.Is(err, ErrBadPattern) {
+			t.Errorf("Compile(%q): expected ErrBadPattern, got %v", pattern, err)
+		}
+		if _, err := CompileFold(pattern, true, Options{}); !errors.Is(err, ErrBadPattern) {
+			t.Errorf("CompileFold(%q): expected ErrBadPattern, got %v", pattern, err)
+		}
+	}
+}
+
+// TestCompileInstructions validates the shape of the instruction list
+func TestCompileInstructions(t *testing.T) {
+	tests := []struct {
+		pattern string
+		ops     []opcode
+	}{
+		{"", nil},
+		{"abc", []opcode{opLiteral}},
+		{"*?*?", []opcode{opStar}},
+		{"?*", []opcode{opStar}},
+		{"a??b", []opcode{opLiteral, opQuestion, opLiteral}},
+		{"a\\*b", []opcode{opLiteral}},
+		{"[a-z].*", []opcode{opClass, opDot, opStar}},
+		{"a{b,c}d", []opcode{opLiteral, opAlt, opLiteral, opJump, opLiteral, opLiteral}},
+		{"{a,{b,c}}", []opcode{opAlt, opLiteral, opJump, opAlt, opLiteral, opJump, opLiteral}},
+		{"a\\{b,c}", []opcode{opLiteral}},
+	}
+
+	for _, tt := range tests {
+		prog, err := Compile(tt.pattern, Options{})
+		if err != nil {
+			t.Fatalf("Compile(%q) failed: %v", tt.pattern, err)
+		}
+		var ops []opcode
+		for _, in := range prog.insts {
+			ops = append(ops, in.op)
+		}
+		if !slices.Equal(ops, tt.ops) {
+			t.Errorf("Compile(%q): expected ops %v, got %v", tt.pattern, tt.ops, ops)
+		}
+	}
+
+	prog, _ := Compile("a\\*b\\", Options{})
+	if prog.insts[0].lit != "a*b\\" {
+		t.Errorf("Expected unescaped literal %q, got %q", "a*b\\", prog.insts[0].lit)
+	}
+}
+
+// wildcardToRegexp translates a pattern made of literals, `*`, `?` and `.` into an
+// anchored regular expression with the same meaning, as a reference implementation.
+func wildcardToRegexp(pattern string) string {
+	var b strings.Builder
+	b.WriteString(`^(?s:`)
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			b.WriteString(`.*`)
+		case '?':
+			b.WriteString(`.?`)
+		case '.':
+			b.WriteString(`[^\n]`)
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString(`)$`)
+	return b.String()
+}
+
+// FuzzCompile checks compiled programs against a regexp reference implementation
+func FuzzCompile(f *testing.F) {
+	f.Add("?.?", "bbb")
+	f.Add("a.?b?", "aabbb")
+	f.Add("*a*b?", "xaxb")
+	f.Add("???a?", "abaa")
+	f.Add("h?llo*world", "hello world")
+
+	f.Fuzz(func(t *testing.T, pattern, s string) {
+		if strings.ContainsAny(pattern, "[\\") || !utf8.ValidString(pattern) {
+			t.Skip("classes, escapes and invalid UTF-8 are not covered by the reference")
+		}
+		re := regexp.MustCompile(wildcardToRegexp(pattern))
+
+		prog, err := Compile(pattern, Options{})
+		if err != nil {
+			t.Fatalf("Compile(%q) failed: %v", pattern, err)
+		}
+		// The byte-oriented program treats every byte as one character
+		if ascii(pattern) && ascii(s) {
+			if got, want := MatchProgram(prog, s), re.MatchString(s); got != want {
+				t.Errorf("Compile(%q) on %q: got %v, want %v", pattern, s, got, want)
+			}
+		}
+
+		progFold, err := CompileFold(pattern, false, Options{})
+		if err != nil {
+			t.Fatalf("CompileFold(%q) failed: %v", pattern, err)
+		}
+		if got, want := MatchProgram(progFold, s), re.MatchString(s); got != want {
+			t.Errorf("CompileFold(%q) on %q: got %v, want %v", pattern, s, got, want)
+		}
+	})
+}
+
+func ascii(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
+
+// TestMatchProgramSubmatch validates the spans reported for each wildcard
+func TestMatchProgramSubmatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		input   string
+		want    []int
+	}{
+		{"user-*-profile-?", "user-42-profile-x", []int{0, 17, 5, 7, 16, 17}},
+		{"user-*-profile-?", "user-42-profile-", []int{0, 16, 5, 7, 16, 16}},
+		{"user-*-profile-?", "account-42", nil},
+		{"abc", "abc", []int{0, 3}},
+		{"*\\.*", "a.b.c", []int{0, 5, 0, 1, 2, 5}}, // Stars take as little as possible
+		{"*.*", "a.b.c", []int{0, 5, 0, 0, 0, 1, 1, 5}},
+		{"*?*", "abc", []int{0, 3, 0, 3}}, // A run of wildcards is one capture
+		{"f.le[0-9]", "file7", []int{0, 5, 1, 2, 4, 5}},
+		{"*\\.{png,p?g}", "a.png", []int{0, 5, 0, 1, -1, -1}}, // `?` of the untaken alternative
+		{"*\\.{png,p?g}", "a.pg", []int{0, 4, 0, 1, 3, 3}},
+		{"{*b,a*}x", "abx", []int{0, 3, 0, 1, -1, -1}},
+	}
+
+	for _, tt := range tests {
+		prog, err := Compile(tt.pattern, Options{})
+		if err != nil {
+			t.Fatalf("Compile(%q) failed: %v", tt.pattern, err)
+		}
+		if got := MatchProgramSubmatch(prog, tt.input); !slices.Equal(got, tt.want) {
+			t.Errorf("MatchProgramSubmatch(%q, %q): expected %v, got %v", tt.pattern, tt.input, tt.want, got)
+		}
+		if got := MatchProgramSubmatch(prog, []byte(tt.input)); !slices.Equal(got, tt.want) {
+			t.Errorf("MatchProgramSubmatch(%q, []byte %q): expected %v, got %v", tt.pattern, tt.input, tt.want, got)
+		}
+	}
+
+	// Globstars report the directories they crossed, including the last separator
+	prog, _ := Compile("src/**/*\\.go", Options{Separator: '/'})
+	if got, want := MatchProgramSubmatch(prog, "src/a/b/main.go"), []int{0, 15, 4, 8, 8, 12}; !slices.Equal(got, want) {
+		t.Errorf("MatchProgramSubmatch with globstar: expected %v, got %v", want, got)
+	}
+	if prog.NumCaptures() != 2 {
+		t.Errorf("Expected 2 captures, got %d", prog.NumCaptures())
+	}
+
+	// Strict `?` wildcards are captured one by one, unless a star absorbs them
+	prog, _ = Compile("id-??-*?", Options{StrictQuestion: true})
+	if got, want := MatchProgramSubmatch(prog, "id-42-xy"), []int{0, 8, 3, 4, 4, 5, 6, 8}; !slices.Equal(got, want) {
+		t.Errorf("MatchProgramSubmatch with strict ?: expected %v, got %v", want, got)
+	}
+	if prog.NumCaptures() != 3 {
+		t.Errorf("Expected 3 captures, got %d", prog.NumCaptures())
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+// Package wildcard contains optimized wildcard matching implementations.
+// This file provides PatternError, the structured error reported for malformed
+// patterns. It wraps ErrBadPattern so existing errors.Is checks keep working.
+package wildcard
+
+import (
+	"strconv"
+)
+
+// Reasons reported by PatternError.
+const (
+	ReasonUnclosedClass   = "unclosed character class"
+	ReasonInvertedRange   = "inverted range in character class"
+	ReasonDanglingEscape  = "dangling escape in character class"
+	ReasonUnclosedBrace   = "unclosed brace group"
+	ReasonExpectedClass   = "expected character class"
+	ReasonTrailingEscape  = "LIKE pattern ends with escape character"
+	ReasonUnknownClass    = "unknown POSIX character class"
+	ReasonUnknownProperty = "unknown Unicode property"
+	ReasonInvalidUTF8     = "invalid UTF-8"
+)
+
+// PatternError describes a malformed pattern: where the problem is, the token
+// responsible for it, and why it is rejected.
+type PatternError struct {
+	Pattern string // The malformed pattern
+	Offset  int    // Byte offset of Token in Pattern
+	Token   string // The offending token, such as "[" or "z-a"
+	Reason  string // Why the token is malformed, one of the Reason constants
+}
+
+// newPatternError returns a PatternError for the token pattern[start:end].
+func newPatternError[T ~string | ~[]byte](pattern T, start, end int, reason string) *PatternError {
+	start, end = min(start, len(pattern)), min(end, len(pattern))
+	return &PatternError{Pattern: string(pattern), Offset: start, Token: string(pattern[start:end]), Reason: reason}
+}
+
+// Error returns a message locating the problem in the pattern.
+func (e *PatternError) Error() string {
+	return ErrBadPattern.Error() + " " + strconv.Quote(e.Pattern) + " at offset " +
+		strconv.Itoa(e.Offset) + ": " + e.Reason + " " + strconv.Quote(e.Token)
+}
+
+// Unwrap returns ErrBadPattern, so errors.Is(err, ErrBadPattern) reports true
+// for every PatternError.
+func (e *PatternError) Unwrap() error {
+	return ErrBadPattern
+}
@@ -0,0 +1,70 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package wildcard
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestPatternError validates the position, token and reason of malformed patterns
+func TestPatternError(t *testing.T) {
+	tests := []struct {
+		pattern string
+		offset  int
+		token   string
+		reason  string
+	}{
+		{"file[z-a].txt", 5, "z-a", ReasonInvertedRange},
+		{"[\\z-a]", 1, "\\z-a", ReasonInvertedRange},
+		{"abc[", 3, "[", ReasonUnclosedClass},
+		{"abc[!", 3, "[", ReasonUnclosedClass},
+		{"a[bc", 1, "[", ReasonUnclosedClass},
+		{"a[b\\", 3, "\\", ReasonDanglingEscape},
+		{"a[b-\\", 4, "\\", ReasonDanglingEscape},
+		{"*.{jpg,png", 2, "{", ReasonUnclosedBrace},
+		{"{a,{b,c}", 0, "{", ReasonUnclosedBrace},
+		{"é[ü-a]", 3, "ü-a", ReasonInvertedRange},
+	}
+
+	for _, tt := range tests {
+		_, err := CompileFold(tt.pattern, true, Options{})
+		errs := []error{err}
+		if ascii(tt.pattern) {
+			// The byte-oriented parser splits multi-byte runes
+			_, err = Compile(tt.pattern, Options{})
+			errs = append(errs, err)
+		}
+
+		for _, err := range errs {
+			var pe *PatternError
+			if !errors.As(err, &pe) {
+				t.Errorf("%q: expected *PatternError, got %v", tt.pattern, err)
+				continue
+			}
+			if pe.Pattern != tt.pattern || pe.Offset != tt.offset || pe.Token != tt.token || pe.Reason != tt.reason {
+				t.Errorf("%q: expected offset %d, token %q, reason %q; got %+v", tt.pattern, tt.offset, tt.token, tt.reason, *pe)
+			}
+			if !errors.Is(err, ErrBadPattern) {
+				t.Errorf("%q: expected errors.Is(err, ErrBadPattern)", tt.pattern)
+			}
+		}
+	}
+}
+
+// TestPatternErrorMessage validates the error text
+func TestPatternErrorMessage(t *testing.T) {
+	_, err := Compile("file[z-a].txt", Options{})
+	want := `syntax error in pattern "file[z-a].txt" at offset 5: inverted range in character class "z-a"`
+	if err == nil || err.Error() != want {
+		t.Errorf("Expected %q, got %v", want, err)
+	}
+}
@@ -0,0 +1,211 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+// Package wildcard contains optimized wildcard matching implementations.
+// This file provides the generation of example strings for a pattern: random
+// strings it matches, drawn from the automaton built for pattern analysis, and
+// near misses it rejects, for test fixtures, previews and fuzzing corpora.
+package wildcard
+
+import (
+	"math/rand"
+	"slices"
+	"sync"
+	"unicode"
+)
+
+const (
+	// exampleMaxLen is the length in runes from which a generated example heads
+	// straight for the end of the pattern instead of repeating wildcards.
+	exampleMaxLen = 32
+
+	// exampleAttempts is the number of strings generated per requested example
+	// before giving up, for patterns matching few distinct strings.
+	exampleAttempts = 20
+)
+
+// printableASCII is the set of printable ASCII characters, space included.
+var printableASCII = runeSet{{' ', '~'}}
+
+// graphicRunes is the set of runes for which unicode.IsGraphic reports true.
+var graphicRunes = sync.OnceValue(func() runeSet {
+	return funcSet(unicode.IsGraphic)
+})
+
+// Examples returns up to n distinct random strings matched by p, drawing the
+// characters of wildcards and classes from rng. Characters are mostly printable
+// ASCII when the pattern allows them, and wildcards repeat a few times at most.
+// Fewer than n strings are returned when p matches fewer, and none when it
+// matches nothing. It accepts the same programs as Subsumes.
+func Examples(p *Program, n int, rng *rand.Rand) ([]string, error) {
+	a, err := newNFA(p)
+	if err != nil {
+		return nil, err
+	}
+	dist := a.distances()
+
+	var examples []string
+	for range n * exampleAttempts {
+		if len(examples) == n || dist[0] < 0 {
+			break
+		}
+		if s := a.walk(dist, rng); !slices.Contains(examples, s) {
+			examples = append(examples, s)
+		}
+	}
+	return examples, nil
+}
+
+// CounterExamples returns up to n distinct random strings rejected by p, each a
+// near miss: a string p matches with one character inserted, deleted or
+// replaced. Fewer than n strings are returned when few such strings exist, and
+// none when p matches everything. It accepts the same programs as Subsumes.
+func CounterExamples(p *Program, n int, rng *rand.Rand) ([]string, error) {
+	a, err := newNFA(p)
+	if err != nil {
+		return nil, err
+	}
+	dist := a.distances()
+
+	// Replacement characters likely to matter to the pattern
+	pool := []rune{'\n', ' ', 'x', '0', 'é'}
+	if p.sep != 0 {
+		pool = append(pool, p.sep)
+	}
+	for _, in := range p.insts {
+		for _, r := range in.lit {
+			pool = append(pool, r)
+		}
+	}
+
+	var counters []string
+	for range n * exampleAttempts {
+		if len(counters) == n {
+			break
+		}
+		var base []rune
+		if dist[0] >= 0 {
+			base = []rune(a.walk(dist, rng))
+		}
+		s := string(mutate(base, pool, rng))
+		if !MatchProgram(p, s) && !slices.Contains(counters, s) {
+			counters = append(counters, s)
+		}
+	}
+	return counters, nil
+}
+
+// mutate inserts, deletes or replaces a random character of s, taking new
+// characters from pool or from printable ASCII.
+func mutate(s, pool []rune, rng *rand.Rand) []rune {
+	r := pool[rng.Intn(len(pool))]
+	if rng.Intn(2) == 0 {
+		r = randomRune(printableASCII, rng)
+	}
+	i := rng.Intn(len(s) + 1)
+	switch op := rng.Intn(3); {
+	case op == 0 || len(s) == 0:
+		return slices.Insert(s, i, r)
+	case i == len(s):
+		return s[:i-1]
+	case op == 1:
+		return slices.Delete(s, i, i+1)
+	default:
+		s[i] = r
+		return s
+	}
+}
+
+// distances returns, for each state of n, the least number of runes consumed on
+// the way to the accepting state, or -1 if it cannot be reached.
+func (n *nfa) distances() []int {
+	dist := make([]int, len(n.states))
+	for i := range dist {
+		dist[i] = -1
+	}
+	dist[n.accept] = 0
+
+	// Relax transitions until no distance improves; the automata are small
+	for changed := true; changed; {
+		changed = false
+		for s, state := range n.states {
+			best := dist[s]
+			for _, t := range state.eps {
+				if d := dist[t]; d >= 0 && (best < 0 || d < best) {
+					best = d
+				}
+			}
+			for _, e := range state.edges {
+				if d := dist[e.to]; d >= 0 && (best < 0 || d+1 < best) {
+					best = d + 1
+				}
+			}
+			if best != dist[s] {
+				dist[s] = best
+				changed = true
+			}
+		}
+	}
+	return dist
+}
+
+// walk returns a random string accepted by n, choosing uniformly among the
+// transitions leading to the accepting state at every step. Once the string
+// reaches exampleMaxLen runes, only transitions on a shortest way to the
+// accepting state are taken. dist must come from n.distances and reach the
+// accepting state from the start.
+func (n *nfa) walk(dist []int, rng *rand.Rand) string {
+	type move struct {
+		to   int
+		set  runeSet // nil for a transition consuming no input
+		stop bool
+	}
+
+	var out []rune
+	var moves []move
+	for s := 0; ; {
+		short := len(out) >= exampleMaxLen
+		moves = moves[:0]
+		if s == n.accept {
+			moves = append(moves, move{stop: true})
+		}
+		for _, t := range n.states[s].eps {
+			if dist[t] >= 0 && (!short || dist[t] == dist[s]) {
+				moves = append(moves, move{to: t})
+			}
+		}
+		for _, e := range n.states[s].edges {
+			if dist[e.to] >= 0 && (!short || dist[e.to]+1 == dist[s]) {
+				moves = append(moves, move{to: e.to, set: e.set})
+			}
+		}
+
+		m := moves[rng.Intn(len(moves))]
+		if m.stop {
+			return string(out)
+		}
+		if m.set != nil {
+			out = append(out, randomRune(m.set, rng))
+		}
+		s = m.to
+	}
+}
+
+// randomRune returns a random rune of set, preferring printable ASCII and
+// then graphic characters when set contains some.
+func randomRune(set runeSet, rng *rand.Rand) rune {
+	if ascii := set.intersect(printableASCII); len(ascii) > 0 && rng.Intn(8) > 0 {
+		set = ascii
+	} else if graphic := set.intersect(graphicRunes()); len(graphic) > 0 {
+		set = graphic
+	}
+	rg := set[rng.Intn(len(set))]
+	return rg.lo + rune(rng.Int63n(int64(rg.hi-rg.lo+1)))
+}
@@ -0,0 +1,113 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package wildcard
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+	"unicode/utf8"
+)
+
+// exampleCases are patterns of every kind, with the options they are compiled with
+var exampleCases = []struct {
+	pattern string
+	opts    Options
+}{
+	{"*", Options{}},
+	{"admin-*", Options{}},
+	{"file?.txt", Options{}},
+	{"file?.txt", Options{StrictQuestion: true, LiteralDot: true}},
+	{"a.b", Options{}},
+	{"[a-c][!0-9]\\p{Greek}", Options{}},
+	{"[[:digit:]]??x", Options{}},
+	{"*.{jpg,png,tar.gz}", Options{LiteralDot: true}},
+	{"{a,b{c,d}}*e", Options{}},
+	{"src/**/*.go", Options{Separator: '/'}},
+	{"**/x/*", Options{Separator: '/'}},
+	{"literal", Options{}},
+	{"", Options{}},
+}
+
+// TestExamples validates that generated examples are distinct strings matched
+// by the pattern
+func TestExamples(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, tc := range exampleCases {
+		prog, err := CompileFold(tc.pattern, false, tc.opts)
+		if err != nil {
+			t.Fatalf("CompileFold(%q): %v", tc.pattern, err)
+		}
+		examples, err := Examples(prog, 10, rng)
+		if err != nil {
+			t.Fatalf("Examples(%q): %v", tc.pattern, err)
+		}
+		if len(examples) == 0 || len(examples) > 10 {
+			t.Errorf("Examples(%q) returned %d strings", tc.pattern, len(examples))
+		}
+		for i, s := range examples {
+			if !utf8.ValidString(s) || !MatchProgram(prog, s) {
+				t.Errorf("Examples(%q) returned %q, which it does not match", tc.pattern, s)
+			}
+			if slices.Contains(examples[:i], s) {
+				t.Errorf("Examples(%q) returned %q twice", tc.pattern, s)
+			}
+		}
+	}
+
+	// Patterns matching a single string yield it once
+	prog, _ := CompileFold("abc", false, Options{})
+	if got, _ := Examples(prog, 5, rng); !slices.Equal(got, []string{"abc"}) {
+		t.Errorf("Examples(\"abc\") = %q, expected [\"abc\"]", got)
+	}
+
+	// Patterns matching nothing yield nothing
+	prog, _ = CompileFold("[!\\p{L}\\P{L}]", false, Options{})
+	if got, _ := Examples(prog, 5, rng); len(got) != 0 {
+		t.Errorf("Examples of an empty class = %q, expected none", got)
+	}
+}
+
+// TestCounterExamples validates that counterexamples are distinct strings
+// rejected by the pattern
+func TestCounterExamples(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, tc := range exampleCases {
+		prog, _ := CompileFold(tc.pattern, false, tc.opts)
+		counters, err := CounterExamples(prog, 10, rng)
+		if err != nil {
+			t.Fatalf("CounterExamples(%q): %v", tc.pattern, err)
+		}
+		if tc.pattern != "*" && len(counters) == 0 {
+			t.Errorf("CounterExamples(%q) returned no strings", tc.pattern)
+		}
+		for i, s := range counters {
+			if MatchProgram(prog, s) {
+				t.Errorf("CounterExamples(%q) returned %q, which it matches", tc.pattern, s)
+			}
+			if slices.Contains(counters[:i], s) {
+				t.Errorf("CounterExamples(%q) returned %q twice", tc.pattern, s)
+			}
+		}
+	}
+
+	// Nothing is rejected by a pattern matching everything
+	prog, _ := CompileFold("*", false, Options{})
+	if got, _ := CounterExamples(prog, 5, rng); len(got) != 0 {
+		t.Errorf("CounterExamples(\"*\") = %q, expected none", got)
+	}
+
+	prog, _ = CompileFold("a*", true, Options{Graphemes: true})
+	if _, err := CounterExamples(prog, 5, rng); !errors.Is(err, errors.ErrUnsupported) {
+		t.Errorf("CounterExamples with graphemes: expected ErrUnsupported, got %v", err)
+	}
+}
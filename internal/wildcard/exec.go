@@ -0,0 +1,554 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+// Package wildcard contains optimized wildcard matching implementations.
+// This file provides the matcher that executes a compiled Program.
+// It backtracks only at `*`, `**`, `?` and brace instructions and memoizes failed states,
+// which bounds the work to O(m*n) for m instructions and input length n.
+package wildcard
+
+import (
+	"bytes"
+	"strings"
+)
+
+// MatchProgram reports whether s matches the compiled program p.
+// A program built by Compile follows the wildcard semantics of MatchInternal,
+// one built by CompileFold those of MatchInternalFold. Under the UTF8Reject
+// policy, an input that is not valid UTF-8 never matches.
+func MatchProgram[T ~string | ~[]byte](p *Program, s T) bool {
+	if rejects(p, s) {
+		return false
+	}
+	m := matcher[T]{prog: p, s: s}
+	return m.match(0, 0)
+}
+
+// MatchProgramSubmatch matches s against p and returns the capture spans as index
+// pairs in the style of regexp.Regexp.FindSubmatchIndex: the first pair covers the
+// whole input, followed by one pair per capture of p in pattern order. A capture
+// inside a brace alternative that was not taken is reported as -1, -1. It returns
+// nil if s does not match.
+func MatchProgramSubmatch[T ~string | ~[]byte](p *Program, s T) []int {
+	if rejects(p, s) {
+		return nil
+	}
+	caps := make([]int, 2+2*p.captures)
+	for i := range caps {
+		caps[i] = -1
+	}
+	m := matcher[T]{prog: p, s: s, caps: caps[2:]}
+	if !m.match(0, 0) {
+		return nil
+	}
+	caps[0], caps[1] = 0, len(s)
+	return caps
+}
+
+// matcher holds the per-call state used to execute a Program against one input.
+type matcher[T ~string | ~[]byte] struct {
+	prog *Program
+	s    T
+
+	// starFail records, per instruction, one more than the lowest input position
+	// from which a star is known to fail (zero means unknown). A star that fails
+	// from position i also fails from every later position, so this single
+	// bound replaces a full memo table for stars. Small programs use the inline
+	// array to keep matching free of heap allocations.
+	starFail      [8]int
+	starFailLarge []int
+
+	// stateFail is a bitset of (slot, position) states known to fail, for the
+	// memoized instructions. Short inputs use the inline array, like starFail.
+	stateFail      [4]uint64
+	stateFailLarge []uint64
+
+	// caps receives the start and end of each capture when the caller asked for
+	// them, nil otherwise. Values written by failed attempts are overwritten by
+	// the successful path, or cleared for brace alternatives it did not take.
+	caps []int
+
+	// longest switches the matcher to search mode (see find.go): instead of
+	// requiring the program to consume the whole input, every position where it
+	// ends is recorded in best, and matching continues to look for later ones.
+	longest bool
+	best    int
+}
+
+// match reports whether the instructions from pc onwards match s[si:].
+// Deterministic instructions are executed iteratively; recursion only happens at
+// backtracking points, so the depth is bounded by the number of instructions.
+func (m *matcher[T]) match(pc, si int) bool {
+	insts := m.prog.insts
+	sLen := len(m.s)
+
+	for ; pc < len(insts); pc++ {
+		in := &insts[pc]
+		switch in.op {
+		case opLiteral:
+			n, ok := m.matchLiteral(in, si)
+			if !ok || m.prog.graphemes && !graphemeEnd(m.s, si, si+n) {
+				return false
+			}
+			si += n
+
+		case opDot:
+			// `.` matches any single character except newline
+			if si >= sLen {
+				return false
+			}
+			r, width := m.next(si)
+			if r == '\n' || m.isSep(r) {
+				return false
+			}
+			m.capture(in, si, si+width)
+			si += width
+
+		case opAny:
+			if si >= sLen {
+				return false
+			}
+			r, width := m.next(si)
+			if m.isSep(r) {
+				return false
+			}
+			m.capture(in, si, si+width)
+			si += width
+
+		case opClass:
+			if si >= sLen {
+				return false
+			}
+			r, width := m.next(si)
+			if m.prog.normalize {
+				// Classes see the composed form of the character
+				r = composedRune(m.s, si, si+width, m.prog.opaque())
+			}
+			if m.isSep(r) || !m.matchClass(in, r) {
+				return false
+			}
+			m.capture(in, si, si+width)
+			si += width
+
+		case opStar:
+			if m.prog.sep != 0 {
+				return m.segmentStar(pc, si)
+			}
+			return m.star(pc, si)
+
+		case opGlobstar:
+			return m.globstar(pc, si)
+
+		case opQuestion:
+			return m.question(pc, si)
+
+		case opAlt:
+			return m.alt(pc, si)
+
+		case opJump:
+			// Skip the remaining alternatives of the brace group
+			pc = in.jump - 1
+		}
+	}
+
+	if m.longest {
+		return m.end(si)
+	}
+	return si == sLen
+}
+
+// star tries every split point for the `*` at pc, from the shortest to the longest.
+func (m *matcher[T]) star(pc, si int) bool {
+	insts := m.prog.insts
+	sLen := len(m.s)
+
+	start := si
+	if n := insts[pc].min; n > 0 {
+		var ok bool
+		if si, ok = m.skip(si, n); !ok {
+			return false
+		}
+	}
+
+	// A trailing star matches the rest of the input
+	if pc+1 == len(insts) {
+		m.capture(&insts[pc], start, sLen)
+		return !m.longest || m.end(sLen)
+	}
+
+	// Positions from end onwards are already known to fail
+	end := sLen + 1
+	if f := m.starFailBound(pc); *f != 0 {
+		end = *f - 1
+		if si >= end {
+			return false
+		}
+	}
+
+	next := &insts[pc+1]
+	if next.op == opLiteral && m.prog.searchLiterals() {
+		if pc+2 == len(insts) && !m.longest {
+			// A final literal can only be placed at the very end of the input
+			k := sLen - len(next.lit)
+			if k >= si && k < end && m.match(pc+1, k) {
+				m.capture(&insts[pc], start, k)
+				return true
+			}
+		} else {
+			// Jump straight to each occurrence of the literal following the star
+			for k := si; k < end; k++ {
+				idx := m.index(k, next)
+				if idx < 0 {
+					break
+				}
+				k += idx
+				if k >= end {
+					break
+				}
+				if m.match(pc+1, k) {
+					m.capture(&insts[pc], start, k)
+					return true
+				}
+			}
+		}
+	} else {
+		for k := si; k < end; {
+			if m.match(pc+1, k) {
+				m.capture(&insts[pc], start, k)
+				return true
+			}
+			if k == sLen {
+				break
+			}
+			_, width := m.next(k)
+			k += width
+		}
+	}
+
+	*m.starFailBound(pc) = si + 1
+	return false
+}
+
+// starFailBound returns the starFail entry of the star at pc.
+func (m *matcher[T]) starFailBound(pc int) *int {
+	if pc < len(m.starFail) {
+		return &m.starFail[pc]
+	}
+	if m.starFailLarge == nil {
+		m.starFailLarge = make([]int, len(m.prog.insts))
+	}
+	return &m.starFailLarge[pc]
+}
+
+// segmentStar tries every split point for the `*` at pc when a separator is set.
+// The star cannot cross a separator, so the split points are limited to the
+// current path segment.
+func (m *matcher[T]) segmentStar(pc, si int) bool {
+	in := &m.prog.insts[pc]
+	if m.failed(in, si) {
+		return false
+	}
+
+	k, ok := m.skip(si, in.min)
+	for ok {
+		if m.match(pc+1, k) {
+			m.capture(in, si, k)
+			return true
+		}
+		if k == len(m.s) {
+			break
+		}
+		r, width := m.next(k)
+		if m.isSep(r) {
+			break
+		}
+		k += width
+	}
+
+	m.markFailed(in, si)
+	return false
+}
+
+// globstar tries every split point for the `**` path segment at pc. A globstar
+// that absorbed its trailing separator matches zero or more whole segments,
+// so it may only end where a segment starts.
+func (m *matcher[T]) globstar(pc, si int) bool {
+	in := &m.prog.insts[pc]
+	if !in.dirs && pc+1 == len(m.prog.insts) {
+		// A trailing `**` matches the rest of the input
+		m.capture(in, si, len(m.s))
+		return !m.longest || m.end(len(m.s))
+	}
+	if m.failed(in, si) {
+		return false
+	}
+
+	for k := si; ; {
+		if m.match(pc+1, k) {
+			m.capture(in, si, k)
+			return true
+		}
+		if k == len(m.s) {
+			break
+		}
+		r, width := m.next(k)
+		k += width
+		if in.dirs {
+			// Skip to the start of the next segment
+			for !m.isSep(r) && k < len(m.s) {
+				r, width = m.next(k)
+				k += width
+			}
+			if !m.isSep(r) {
+				break
+			}
+		}
+	}
+
+	m.markFailed(in, si)
+	return false
+}
+
+// question tries matching zero up to n characters for the `?` run at pc.
+func (m *matcher[T]) question(pc, si int) bool {
+	in := &m.prog.insts[pc]
+	if m.failed(in, si) {
+		return false
+	}
+
+	// Try matching as few characters as possible first
+	k, ok := m.skip(si, in.min)
+	for i := in.min; ok; i++ {
+		if m.match(pc+1, k) {
+			m.capture(in, si, k)
+			return true
+		}
+		if i == in.n || k == len(m.s) {
+			break
+		}
+		r, width := m.next(k)
+		if m.isSep(r) {
+			break
+		}
+		k += width
+	}
+
+	m.markFailed(in, si)
+	return false
+}
+
+// alt tries each alternative of the brace group at pc, in pattern order.
+func (m *matcher[T]) alt(pc, si int) bool {
+	in := &m.prog.insts[pc]
+	if m.failed(in, si) {
+		return false
+	}
+
+	for _, target := range in.alts {
+		if m.caps != nil {
+			// Forget captures left by earlier attempts at this group
+			for i := pc + 1; i < in.jump; i++ {
+				m.capture(&m.prog.insts[i], -1, -1)
+			}
+		}
+		if m.match(target, si) {
+			return true
+		}
+	}
+
+	m.markFailed(in, si)
+	return false
+}
+
+// skip advances over the n characters required by the instruction at si, none
+// of which may be the separator. It reports false if they are not available.
+func (m *matcher[T]) skip(si, n int) (int, bool) {
+	for ; n > 0; n-- {
+		if si >= len(m.s) {
+			return si, false
+		}
+		r, width := m.next(si)
+		if m.isSep(r) {
+			return si, false
+		}
+		si += width
+	}
+	return si, true
+}
+
+// capture records the span of input consumed by the wildcard instruction in,
+// if captures were requested.
+func (m *matcher[T]) capture(in *instr, start, end int) {
+	if m.caps == nil {
+		return
+	}
+	switch in.op {
+	case opStar, opQuestion, opDot, opClass, opGlobstar, opAny:
+		m.caps[2*in.capture] = start
+		m.caps[2*in.capture+1] = end
+	}
+}
+
+// failed reports whether the memoized instruction in is known to fail from si.
+func (m *matcher[T]) failed(in *instr, si int) bool {
+	bit := in.slot*(len(m.s)+1) + si
+	return m.stateFailBits()[bit/64]&(1<<(bit%64)) != 0
+}
+
+// markFailed records that the memoized instruction in fails from si.
+func (m *matcher[T]) markFailed(in *instr, si int) {
+	bit := in.slot*(len(m.s)+1) + si
+	m.stateFailBits()[bit/64] |= 1 << (bit % 64)
+}
+
+// stateFailBits returns the stateFail bitset sized for the current input.
+func (m *matcher[T]) stateFailBits() []uint64 {
+	words := (m.prog.slots*(len(m.s)+1) + 63) / 64
+	if words <= len(m.stateFail) {
+		return m.stateFail[:words]
+	}
+	if m.stateFailLarge == nil {
+		m.stateFailLarge = make([]uint64, words)
+	}
+	return m.stateFailLarge
+}
+
+// isSep reports whether r is the path separator of the program.
+func (m *matcher[T]) isSep(r rune) bool {
+	return m.prog.sep != 0 && r == m.prog.sep
+}
+
+// next returns the character at s[si] and its width: a single byte for
+// byte-oriented programs, a decoded rune for Unicode programs, along with the
+// combining marks following it when accents are ignored. In grapheme-aware
+// programs the character is a whole grapheme cluster, and in normalization-
+// insensitive ones a canonical unit, reported by its first rune.
+func (m *matcher[T]) next(si int) (rune, int) {
+	if !m.prog.unicode {
+		return rune(m.s[si]), 1
+	}
+	r, width := decodeChar(m.s, si, m.prog.opaque())
+	switch {
+	case m.prog.graphemes:
+		width = graphemeWidth(m.s, si)
+		if r == '\r' && width == 2 {
+			// A CR LF line break is a single cluster and counts as a newline
+			r = '\n'
+		}
+	case m.prog.normalize:
+		// The characters combining with a character belong to it
+		width = canonicalEnd(m.s, si+width, m.prog.ignoreAccents) - si
+	case m.prog.ignoreAccents:
+		// The combining marks following a character belong to it
+		width = skipMarks(m.s, si+width) - si
+	}
+	return r, width
+}
+
+// letter returns the rune at s[si] as compared with literals, with any accent
+// removed when accents are ignored, and its width.
+func (m *matcher[T]) letter(si int) (rune, int) {
+	r, width := decodeChar(m.s, si, m.prog.opaque())
+	if m.prog.ignoreAccents {
+		width = skipMarks(m.s, si+width) - si
+		r = baseLetter(r)
+	}
+	return r, width
+}
+
+// matchClass reports whether r belongs to the character class of in.
+func (m *matcher[T]) matchClass(in *instr, r rune) bool {
+	if !m.prog.unicode {
+		return in.class.matches(byte(r))
+	}
+	if m.prog.ignoreAccents {
+		if b := baseLetter(r); b != r {
+			// An accented letter belongs to the class when either it or its base
+			// letter does, so a negated class must reject both
+			if in.classFold.Negated {
+				return m.matchClassRune(in, r) && m.matchClassRune(in, b)
+			}
+			return m.matchClassRune(in, r) || m.matchClassRune(in, b)
+		}
+	}
+	return m.matchClassRune(in, r)
+}
+
+// matchClassRune reports whether r belongs to the character class of in in a
+// Unicode program.
+func (m *matcher[T]) matchClassRune(in *instr, r rune) bool {
+	if m.prog.foldClasses {
+		return in.classFold.matchesFolded(r, m.prog.turkic)
+	}
+	return in.classFold.MatchesWithFold(r, m.prog.fold)
+}
+
+// matchLiteral matches the literal of in at s[si:] and returns the number of
+// input bytes it consumed.
+func (m *matcher[T]) matchLiteral(in *instr, si int) (int, bool) {
+	s := m.s
+	lit := in.lit
+
+	if m.prog.exactLiterals() {
+		if len(s)-si < len(lit) {
+			return 0, false
+		}
+		for i := 0; i < len(lit); i++ {
+			if s[si+i] != lit[i] {
+				return 0, false
+			}
+		}
+		return len(lit), true
+	}
+
+	if m.prog.normalize {
+		return m.matchCanonical(lit, si)
+	}
+
+	start := si
+	if m.prog.ignoreAccents {
+		// Literals have every combining mark removed, including those that do
+		// not follow a letter, which the input may start with here
+		si = skipMarks(s, si)
+	}
+	if m.prog.fullFold {
+		n, ok := m.matchFullFold(lit, si)
+		return si - start + n, ok
+	}
+
+	// Case-insensitive or accent-insensitive comparison, one character at a time
+	for pi := 0; pi < len(lit); {
+		if si >= len(s) {
+			return 0, false
+		}
+		pRune, pWidth := decodeChar(lit, pi, m.prog.opaque())
+		sRune, sWidth := m.letter(si)
+		if !m.prog.equalRune(pRune, sRune) {
+			return 0, false
+		}
+		pi += pWidth
+		si += sWidth
+	}
+	return si - start, true
+}
+
+// index returns the offset of the first occurrence of the literal of in within
+// s[from:], or -1 if there is none.
+func (m *matcher[T]) index(from int, in *instr) int {
+	switch s := any(m.s).(type) {
+	case string:
+		return strings.Index(s[from:], in.lit)
+	case []byte:
+		return bytes.Index(s[from:], in.litBytes)
+	default:
+		// Named string or byte slice types
+		return strings.Index(string(m.s[from:]), in.lit)
+	}
+}
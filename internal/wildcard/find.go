@@ -0,0 +1,133 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+// Package wildcard contains optimized wildcard matching implementations.
+// This file provides unanchored search: finding the substrings of an input that
+// match a compiled Program, rather than matching the input as a whole.
+// Candidate start positions are located with strings.Index/bytes.Index when the
+// program begins with a literal, as MatchInternal does for literals after `*`.
+package wildcard
+
+// IndexProgram returns the leftmost substring of s[from:] matching p as a pair of
+// indexes into s. Among the matches starting at the leftmost position, the
+// longest is chosen. It returns -1, -1 if there is no match.
+func IndexProgram[T ~string | ~[]byte](p *Program, s T, from int) (int, int) {
+	if rejects(p, s) {
+		return -1, -1
+	}
+	return indexProgram(p, s, from)
+}
+
+// indexProgram implements IndexProgram for an input accepted by p.
+func indexProgram[T ~string | ~[]byte](p *Program, s T, from int) (int, int) {
+	m := matcher[T]{prog: p, s: s, longest: true}
+	return m.find(from)
+}
+
+// FindAllProgram returns the successive non-overlapping matches of p in s, as
+// found by IndexProgram, with the conventions of regexp.Regexp.FindAllIndex: an
+// empty match abutting a preceding match is ignored, and if n >= 0 at most n
+// matches are returned. It returns nil if there is no match.
+func FindAllProgram[T ~string | ~[]byte](p *Program, s T, n int) [][]int {
+	if rejects(p, s) {
+		return nil
+	}
+
+	var out [][]int
+	prevEnd := -1
+	for pos := 0; pos <= len(s) && (n < 0 || len(out) < n); {
+		start, end := indexProgram(p, s, pos)
+		if start < 0 {
+			break
+		}
+
+		if end == start && start == prevEnd {
+			// Skip the empty match right after the previous one
+			if start == len(s) {
+				break
+			}
+			pos = start + width(p, s, start)
+			continue
+		}
+
+		out = append(out, []int{start, end})
+		prevEnd = end
+		pos = end
+		if end == start {
+			if start == len(s) {
+				break
+			}
+			pos += width(p, s, start)
+		}
+	}
+	return out
+}
+
+// width returns the width of the character at s[i] for the program p.
+func width[T ~string | ~[]byte](p *Program, s T, i int) int {
+	if !p.unicode {
+		return 1
+	}
+	if p.graphemes {
+		return graphemeWidth(s, i)
+	}
+	_, w := decodeRune(s, i)
+	if p.normalize {
+		return canonicalEnd(s, i+w, p.ignoreAccents) - i
+	}
+	if p.ignoreAccents {
+		return skipMarks(s, i+w) - i
+	}
+	return w
+}
+
+// find tries each start position from from onwards and returns the first one
+// where the program matches, with the longest end found for it.
+//
+// States that fail are memoized across start positions: search mode only moves
+// on to the next start when no state reached the end of the program, so every
+// explored state is a genuine failure.
+func (m *matcher[T]) find(from int) (int, int) {
+	insts := m.prog.insts
+	var first *instr
+	if len(insts) > 0 && insts[0].op == opLiteral && m.prog.searchLiterals() {
+		// Only positions where the leading literal occurs can start a match
+		first = &insts[0]
+	}
+
+	for start := from; start <= len(m.s); {
+		if first != nil {
+			idx := m.index(start, first)
+			if idx < 0 {
+				break
+			}
+			start += idx
+		}
+
+		m.best = -1
+		if m.match(0, start) || m.best >= 0 {
+			return start, m.best
+		}
+
+		if start == len(m.s) {
+			break
+		}
+		_, w := m.next(start)
+		start += w
+	}
+	return -1, -1
+}
+
+// end records that the program can end at si in search mode. It reports true
+// once the end of the input is reached, as no longer match is possible.
+func (m *matcher[T]) end(si int) bool {
+	m.best = max(m.best, si)
+	return si == len(m.s)
+}
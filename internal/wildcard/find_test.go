@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+package wildcard
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// TestIndexProgram validates leftmost-longest search for string and []byte input
+func TestIndexProgram(t *testing.T) {
+	tests := []struct {
+		pattern    string
+		input      string
+		start, end int
+	}{
+		{"err*:", "log: error 42: disk full", 5, 14},
+		{"err*:", "log: error 42: disk: full", 5, 20}, // The longest match from the leftmost start
+		{"warn*", "all good", -1, -1},
+		{"b?", "abc", 1, 3},
+		{"*", "abc", 0, 3},
+		{"", "abc", 0, 0},
+		{"x*", "", -1, -1},
+		{"[0-9].[0-9]", "v1.2.3", 1, 4},
+		{"{foo,ba*}", "xbarfoo", 1, 7},
+		{"a\\*", "aa*", 1, 3},
+	}
+
+	for _, tt := range tests {
+		prog, err := Compile(tt.pattern, Options{})
+		if err != nil {
+			t.Fatalf("Compile(%q) failed: %v", tt.pattern, err)
+		}
+		if start, end := IndexProgram(prog, tt.input, 0); start != tt.start || end != tt.end {
+			t.Errorf("IndexProgram(%q, %q): expected %d, %d, got %d, %d", tt.pattern, tt.input, tt.start, tt.end, start, end)
+		}
+		if start, end := IndexProgram(prog, []byte(tt.input), 0); start != tt.start || end != tt.end {
+			t.Errorf("IndexProgram(%q, []byte %q): expected %d, %d, got %d, %d", tt.pattern, tt.input, tt.start, tt.end, start, end)
+		}
+	}
+
+	// Unicode programs only start matches at rune boundaries
+	prog, _ := CompileFold("É?", true, Options{})
+	if start, end := IndexProgram(prog, "caféine", 0); start != 3 || end != 6 {
+		t.Errorf("IndexProgram fold: expected 3, 6, got %d, %d", start, end)
+	}
+}
+
+// TestFindAllProgram validates non-overlapping search and the handling of empty matches
+func TestFindAllProgram(t *testing.T) {
+	tests := []struct {
+		pattern string
+		input   string
+		n       int
+		want    [][]int
+	}{
+		{"id=[0-9]", "id=1 id=x id=7", -1, [][]int{{0, 4}, {10, 14}}},
+		{"id=[0-9]", "id=1 id=x id=7", 1, [][]int{{0, 4}}},
+		{"id=[0-9]", "id=1", 0, nil},
+		{"a?", "aab", -1, [][]int{{0, 2}}},
+		{"?", "ab", -1, [][]int{{0, 1}, {1, 2}}}, // No empty match right after "b"
+		{"?", "", -1, [][]int{{0, 0}}},
+		{"x", "abc", -1, nil},
+	}
+
+	for _, tt := range tests {
+		prog, err := Compile(tt.pattern, Options{})
+		if err != nil {
+			t.Fatalf("Compile(%q) failed: %v", tt.pattern, err)
+		}
+		got := FindAllProgram(prog, tt.input, tt.n)
+		if !slices.EqualFunc(got, tt.want, slices.Equal) {
+			t.Errorf("FindAllProgram(%q, %q, %d): expected %v, got %v", tt.pattern, tt.input, tt.n, tt.want, got)
+		}
+	}
+
+	// Separators bound the matches like they bound wildcards
+	prog, _ := Compile("*\\.go", Options{Separator: '/'})
+	want := [][]int{{0, 7}, {8, 12}}
+	if got := FindAllProgram(prog, "main.go/x.go", -1); !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("FindAllProgram with separator: expected %v, got %v", want, got)
+	}
+}
+
+// FuzzFind checks unanchored search against a leftmost-longest regexp reference
+func FuzzFind(f *testing.F) {
+	f.Add("a?", "aab")
+	f.Add("*b?", "xaxbab")
+	f.Add("?.?", "b\nbb")
+
+	f.Fuzz(func(t *testing.T, pattern, s string) {
+		if strings.ContainsAny(pattern, "[\\{") || !utf8.ValidString(pattern) || !utf8.ValidString(s) {
+			t.Skip("classes, escapes, braces and invalid UTF-8 are not covered by the reference")
+		}
+		expr := strings.TrimSuffix(strings.TrimPrefix(wildcardToRegexp(pattern), "^"), "$")
+		re := regexp.MustCompile(expr)
+		re.Longest()
+		want := re.FindAllStringIndex(s, -1)
+
+		prog, err := CompileFold(pattern, false, Options{})
+		if err != nil {
+			t.Fatalf("CompileFold(%q) failed: %v", pattern, err)
+		}
+		if got := FindAllProgram(prog, s, -1); !slices.EqualFunc(got, want, slices.Equal) {
+			t.Errorf("FindAllProgram(%q, %q): got %v, want %v", pattern, s, got, want)
+		}
+	})
+}
@@ -0,0 +1,95 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+// Package wildcard contains optimized wildcard matching implementations.
+// This file provides full case folding for literals, where one character may fold
+// to several, as 'ß' to "ss" or 'ﬁ' to "fi". Literals are compared as streams of
+// folded runes, so pattern and input may differ in rune count. Wildcards still
+// consume whole input characters, and a literal must start and end on a character
+// boundary of the input: "s" never matches half of 'ß'.
+package wildcard
+
+import "unicode/utf8"
+
+// maxFullFold is the maximum number of runes in a full case folding.
+const maxFullFold = 3
+
+// appendFullFold appends the full case folding of r to buf. Folded runes are
+// reduced to the canonical representative of their SimpleFold orbit, so two
+// runes are equal under case folding exactly when their foldings are identical.
+// With turkic set, the dotted and dotless I letters fold by the Turkic rules.
+func appendFullFold(buf []rune, r rune, turkic bool) []rune {
+	if r >= fullFoldMin && !(turkic && r == capitalDottedI) {
+		if f, ok := fullFoldTable[r]; ok {
+			for _, c := range f {
+				buf = append(buf, foldRune(c, turkic))
+			}
+			return buf
+		}
+	}
+	return append(buf, foldRune(r, turkic))
+}
+
+// foldRune maps r to the canonical representative of its simple case folding.
+func foldRune(r rune, turkic bool) rune {
+	if turkic {
+		if f, ok := turkicFold(r); ok {
+			return f
+		}
+	}
+	if r < utf8.RuneSelf {
+		// ASCII letters are canonicalized to upper case, the lowest rune of their orbit
+		if 'a' <= r && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		return r
+	}
+	return canonicalFold(r)
+}
+
+// matchFullFold matches lit at s[si:] under full case folding and returns the
+// number of input bytes it consumed. The match must end on a character boundary
+// of s.
+func (m *matcher[T]) matchFullFold(lit string, si int) (int, bool) {
+	s, turkic := m.s, m.prog.turkic
+	var litBuf, sBuf [maxFullFold]rune
+	var pending, sPending []rune // Folded runes not compared yet
+
+	start := si
+	for li := 0; li < len(lit) || len(pending) > 0; {
+		if len(pending) == 0 {
+			r, width := decodeChar(lit, li, m.prog.opaque())
+			li += width
+			pending = appendFullFold(litBuf[:0], r, turkic)
+		}
+		if len(sPending) == 0 {
+			if si >= len(s) {
+				return 0, false
+			}
+			r, width := m.letter(si)
+			si += width
+			sPending = appendFullFold(sBuf[:0], r, turkic)
+		}
+
+		n := min(len(pending), len(sPending))
+		for i := 0; i < n; i++ {
+			if pending[i] != sPending[i] {
+				return 0, false
+			}
+		}
+		pending, sPending = pending[n:], sPending[n:]
+	}
+
+	// Input folded runes left over mean the literal ended inside a character
+	if len(sPending) > 0 {
+		return 0, false
+	}
+	return si - start, true
+}
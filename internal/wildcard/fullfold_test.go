@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+package wildcard
+
+import (
+	"slices"
+	"testing"
+)
+
+// fullFoldCases validates one-to-many case folding and wildcard widths around it
+var fullFoldCases = []struct {
+	pattern string
+	input   string
+	want    bool
+}{
+	// One-to-many folds, in either direction
+	{"STRASSE", "straße", true},
+	{"straße", "STRASSE", true},
+	{"straße", "STRAẞE", true},
+	{"fi", "ﬁ", true},
+	{"ﬁle", "FILE", true},
+	{"ǰ", "J̌", true}, // U+01F0 folds to j + COMBINING CARON
+	{"ΐ", "ΐ", true},  // U+0390 and U+1FD3 share their folding
+
+	// Simple folds keep working
+	{"HELLO*", "hello world", true},
+	{"Kelvin", "kelvin", true}, // KELVIN SIGN
+	{"straße", "strasse!", false},
+
+	// A literal never matches part of a character
+	{"s", "ß", false},
+	{"s*", "ß", false},
+	{"*s", "ß", false},
+	{"ss", "ß", true},
+
+	// Wildcards consume whole input characters
+	{"stra?e", "straße", true},
+	{"stra.e", "STRAẞE", true},
+	{"stra?e", "strasse", false},
+	{"stra??e", "strasse", true},
+	{"*SSE", "straße", true},
+	{"*ß*", "grosse strasse", true},
+	{"ma[ß]e", "MASSE", false}, // Classes compare single characters
+	{"{ss,x}t", "ßt", true},
+}
+
+// TestFullFold validates FullFold in MatchInternalFold and in compiled programs
+func TestFullFold(t *testing.T) {
+	opts := Options{FullFold: true}
+	for _, c := range fullFoldCases {
+		if got, err := MatchInternalFoldWithOptions(c.pattern, c.input, true, opts); err != nil || got != c.want {
+			t.Errorf("MatchInternalFoldWithOptions(%q, %q): expected %v, got %v, %v", c.pattern, c.input, c.want, got, err)
+		}
+		if got, err := MatchInternalFoldWithOptions([]byte(c.pattern), []byte(c.input), true, opts); err != nil || got != c.want {
+			t.Errorf("MatchInternalFoldWithOptions([]byte %q, %q): expected %v, got %v, %v", c.pattern, c.input, c.want, got, err)
+		}
+
+		prog, err := CompileFold(c.pattern, true, opts)
+		if err != nil {
+			t.Fatalf("CompileFold(%q) failed: %v", c.pattern, err)
+		}
+		if got := MatchProgram(prog, c.input); got != c.want {
+			t.Errorf("CompileFold(%q) on %q: expected %v, got %v", c.pattern, c.input, c.want, got)
+		}
+	}
+
+	// Without folding the option has no effect
+	if ok, _ := MatchInternalFoldWithOptions("STRASSE", "straße", false, opts); ok {
+		t.Errorf("Expected FullFold to have no effect on case-sensitive matching")
+	}
+}
+
+// TestFullFoldCaptures validates that captures split the input on character boundaries
+func TestFullFoldCaptures(t *testing.T) {
+	prog, _ := CompileFold("*SSE", true, Options{FullFold: true})
+	want := []int{0, 7, 0, 4} // The literal covers "ße", all of 'ß'
+	if got := MatchProgramSubmatch(prog, "straße"); !slices.Equal(got, want) {
+		t.Errorf("MatchProgramSubmatch: expected %v, got %v", want, got)
+	}
+}
+
+// TestFullFoldSet validates that pattern sets do not index literals under full folding
+func TestFullFoldSet(t *testing.T) {
+	p1, _ := CompileFold("STRASSE*", true, Options{FullFold: true})
+	p2, _ := CompileFold("*ﬁle", true, Options{FullFold: true})
+	set := NewSet([]*Program{p1, p2})
+
+	if got := SetMatches(set, "straße 5", nil); !slices.Equal(got, []int{0}) {
+		t.Errorf("Expected [0], got %v", got)
+	}
+	if got := SetMatches(set, "my.FILE", nil); !slices.Equal(got, []int{1}) {
+		t.Errorf("Expected [1], got %v", got)
+	}
+}
@@ -0,0 +1,209 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+// Package wildcard contains optimized wildcard matching implementations.
+// This file provides extended grapheme clusters as defined by UAX #29, Unicode
+// Text Segmentation, for grapheme-aware matching: a base character with its
+// combining marks, an emoji ZWJ sequence or a flag made of two regional
+// indicators is a single user-perceived character, consumed as a whole by `.`,
+// `?` and character classes. The rules are those of Unicode 17.0, and the table
+// in grapheme_table*.go follows the Unicode version of the unicode package, so
+// that clusters agree with the property escapes. Older versions lack the
+// Indic_Conjunct_Break property, which rule GB9c then never applies to.
+package wildcard
+
+import (
+	"slices"
+	"unicode/utf8"
+)
+
+// graphemeProp is the grapheme cluster break property of a rune, with
+// Extended_Pictographic as an additional value.
+type graphemeProp uint8
+
+const (
+	gbOther graphemeProp = iota
+	gbCR
+	gbLF
+	gbControl
+	gbExtend           // Extend, with Indic_Conjunct_Break=Extend from Unicode 15.1
+	gbExtendNoConjunct // Extend, without Indic_Conjunct_Break
+	gbLinker           // Extend, with Indic_Conjunct_Break=Linker
+	gbConsonant        // Otherwise Other, with Indic_Conjunct_Break=Consonant
+	gbZWJ
+	gbRegionalIndicator
+	gbPrepend
+	gbSpacingMark
+	gbL   // Hangul leading consonant
+	gbV   // Hangul vowel
+	gbT   // Hangul trailing consonant
+	gbLV  // Hangul syllable without trailing consonant
+	gbLVT // Hangul syllable with trailing consonant
+	gbExtendedPictographic
+)
+
+// graphemeRange assigns a property to the runes from lo to hi inclusive.
+type graphemeRange struct {
+	lo, hi rune
+	prop   graphemeProp
+}
+
+const (
+	hangulFirst  = 0xAC00 // First precomposed Hangul syllable
+	hangulLast   = 0xD7A3 // Last precomposed Hangul syllable
+	hangulTCount = 28     // Trailing consonants per LV syllable, including none
+)
+
+// graphemePropOf returns the grapheme cluster break property of r.
+func graphemePropOf(r rune) graphemeProp {
+	if r < utf8.RuneSelf {
+		switch {
+		case r == '\r':
+			return gbCR
+		case r == '\n':
+			return gbLF
+		case r < 0x20 || r == 0x7F:
+			return gbControl
+		}
+		return gbOther
+	}
+	if hangulFirst <= r && r <= hangulLast {
+		if (r-hangulFirst)%hangulTCount == 0 {
+			return gbLV
+		}
+		return gbLVT
+	}
+	i, ok := slices.BinarySearchFunc(graphemeTable, r, func(g graphemeRange, r rune) int {
+		switch {
+		case g.hi < r:
+			return -1
+		case g.lo > r:
+			return 1
+		}
+		return 0
+	})
+	if !ok {
+		return gbOther
+	}
+	return graphemeTable[i].prop
+}
+
+// graphemeWidth returns the width in bytes of the extended grapheme cluster
+// starting at s[i], which must be a cluster boundary.
+func graphemeWidth[T ~string | ~[]byte](s T, i int) int {
+	r, width := decodeRune(s, i)
+	end := i + width
+
+	// ASCII followed by ASCII always breaks, except for CR LF
+	if r < utf8.RuneSelf && (end == len(s) || s[end] < utf8.RuneSelf) {
+		if r == '\r' && end < len(s) && s[end] == '\n' {
+			return 2
+		}
+		return 1
+	}
+
+	prev := graphemePropOf(r)
+	pict := prev == gbExtendedPictographic // Extended_Pictographic Extend* ends at prev
+	zwj := false                           // Extended_Pictographic Extend* ZWJ ends at prev
+	ri := 0                                // Regional indicators ending at prev
+	if prev == gbRegionalIndicator {
+		ri = 1
+	}
+	conjunct := conjunctNone // Indic conjunct sequence ending at prev
+	if prev == gbConsonant {
+		conjunct = conjunctConsonant
+	}
+
+	for end < len(s) {
+		r, width := decodeRune(s, end)
+		next := graphemePropOf(r)
+		if !graphemeJoins(prev, next, zwj, ri, conjunct == conjunctLinked) {
+			break
+		}
+
+		switch next {
+		case gbExtendedPictographic:
+			pict, zwj = true, false
+		case gbExtend, gbExtendNoConjunct, gbLinker:
+			zwj = false
+		case gbZWJ:
+			pict, zwj = false, pict
+		default:
+			pict, zwj = false, false
+		}
+		if next == gbRegionalIndicator {
+			ri++
+		} else {
+			ri = 0
+		}
+		switch {
+		case next == gbConsonant:
+			conjunct = conjunctConsonant
+		case next == gbLinker && conjunct != conjunctNone:
+			conjunct = conjunctLinked
+		case next != gbExtend && next != gbZWJ && next != gbLinker:
+			conjunct = conjunctNone
+		}
+
+		prev = next
+		end += width
+	}
+	return end - i
+}
+
+// Progress through an Indic conjunct sequence, a consonant followed by extending
+// characters and linkers, for rule GB9c.
+const (
+	conjunctNone      = iota // No consonant
+	conjunctConsonant        // A consonant, with no linker yet
+	conjunctLinked           // A consonant and at least one linker
+)
+
+// graphemeJoins reports whether there is no cluster boundary between a rune with
+// property prev and one with property next. zwj reports whether prev ends an
+// emoji ZWJ sequence so far, ri counts the regional indicators ending at prev and
+// linked reports whether prev ends an Indic conjunct sequence with a linker.
+func graphemeJoins(prev, next graphemeProp, zwj bool, ri int, linked bool) bool {
+	switch {
+	case prev == gbCR && next == gbLF: // GB3
+		return true
+	case prev == gbCR || prev == gbLF || prev == gbControl: // GB4
+		return false
+	case next == gbCR || next == gbLF || next == gbControl: // GB5
+		return false
+	case prev == gbL && (next == gbL || next == gbV || next == gbLV || next == gbLVT): // GB6
+		return true
+	case (prev == gbLV || prev == gbV) && (next == gbV || next == gbT): // GB7
+		return true
+	case (prev == gbLVT || prev == gbT) && next == gbT: // GB8
+		return true
+	case next == gbExtend || next == gbExtendNoConjunct || next == gbLinker || next == gbZWJ || next == gbSpacingMark: // GB9, GB9a
+		return true
+	case prev == gbPrepend: // GB9b
+		return true
+	case linked && next == gbConsonant: // GB9c
+		return true
+	case zwj && next == gbExtendedPictographic: // GB11
+		return true
+	case prev == gbRegionalIndicator && next == gbRegionalIndicator: // GB12, GB13
+		return ri%2 == 1
+	}
+	return false // GB999
+}
+
+// graphemeEnd reports whether a literal matched at s[start:end] ends on a
+// cluster boundary, start being one.
+func graphemeEnd[T ~string | ~[]byte](s T, start, end int) bool {
+	i := start
+	for i < end {
+		i += graphemeWidth(s, i)
+	}
+	return i == end
+}
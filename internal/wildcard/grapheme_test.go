@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package wildcard
+
+import (
+	"slices"
+	"testing"
+	"unicode"
+)
+
+// TestGraphemeWidth validates cluster segmentation against cases from
+// GraphemeBreakTest.txt, one case per rule
+func TestGraphemeWidth(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{"ab", []string{"a", "b"}},                                         // GB999
+		{"\r\n\n", []string{"\r\n", "\n"}},                                 // GB3, GB4
+		{"a\u0308\u0001", []string{"a\u0308", "\u0001"}},                   // GB9, GB5
+		{"\u0001\u0308", []string{"\u0001", "\u0308"}},                     // GB4
+		{"\u1100\u1100\u1160\u11A8", []string{"\u1100\u1100\u1160\u11A8"}}, // GB6, GB7, GB8
+		{"가\u11A8\u11A8", []string{"가\u11A8\u11A8"}},                       // GB7, GB8 from an LV syllable
+		{"각\u1160", []string{"각", "\u1160"}},                               // LVT × V is not allowed
+		{" \u0903", []string{" \u0903"}},                                   // GB9a
+		{"\u0600 ", []string{"\u0600 "}},                                   // GB9b
+		{"\U0001F476\U0001F3FF\u0308\u200D\U0001F476\U0001F3FF", []string{"\U0001F476\U0001F3FF\u0308\u200D\U0001F476\U0001F3FF"}}, // GB11
+		{"a\u200D\U0001F6D1", []string{"a\u200D", "\U0001F6D1"}},                                                                   // GB11 requires a pictograph
+		{"\U0001F1EB\U0001F1F7\U0001F1E9", []string{"\U0001F1EB\U0001F1F7", "\U0001F1E9"}},                                         // GB12
+		{"a\U0001F1E6\U0001F1E7\U0001F1E8b", []string{"a", "\U0001F1E6\U0001F1E7", "\U0001F1E8", "b"}},                             // GB13
+	}
+	for _, tt := range tests {
+		var got []string
+		for i := 0; i < len(tt.input); {
+			w := graphemeWidth(tt.input, i)
+			got = append(got, tt.input[i:i+w])
+			i += w
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("graphemeWidth(%+q): expected clusters %+q, got %+q", tt.input, tt.want, got)
+		}
+		if gotBytes := graphemeWidth([]byte(tt.input), 0); gotBytes != len(tt.want[0]) {
+			t.Errorf("graphemeWidth([]byte %+q): expected %d, got %d", tt.input, len(tt.want[0]), gotBytes)
+		}
+	}
+
+	// GB9c joins Indic consonants through a virama from Unicode 15.1, without
+	// which the second consonant starts a cluster
+	conjuncts := []struct {
+		input     string
+		want, old int
+	}{
+		{"\u0915\u094D\u0924", 9, 6},        // KA VIRAMA TA
+		{"\u0915\u094D\u200D\u0924", 12, 9}, // KA VIRAMA ZWJ TA
+		{"\u0915\u0300\u094D\u0924", 11, 8}, // KA GRAVE VIRAMA TA
+		{"\u0915\u0924", 3, 3},              // No linker
+		{"\u0915\u094D\u0061", 6, 6},        // Not a consonant
+	}
+	for _, tt := range conjuncts {
+		want := tt.want
+		if graphemeUnicodeVersion == "15.0.0" {
+			want = tt.old
+		}
+		if got := graphemeWidth(tt.input, 0); got != want {
+			t.Errorf("graphemeWidth(%+q): expected %d, got %d", tt.input, want, got)
+		}
+	}
+}
+
+// TestGraphemeUnicodeVersion validates that clusters follow the Unicode version
+// of the unicode package, which property escapes and classes use
+func TestGraphemeUnicodeVersion(t *testing.T) {
+	if graphemeUnicodeVersion != unicode.Version {
+		t.Errorf("grapheme table of Unicode %s, but unicode.Version is %s", graphemeUnicodeVersion, unicode.Version)
+	}
+}
+
+// graphemeCases validates grapheme-aware wildcards and literals, with "e\u0301"
+// spelling 'é' as a base letter and a combining mark
+var graphemeCases = []struct {
+	pattern string
+	input   string
+	want    bool
+}{
+	// Flags and emoji sequences are single characters
+	{"flag.", "flag🇫🇷", true},
+	{"flag?", "flag🇫🇷", true},
+	{"flag..", "flag🇫🇷", false},
+	{"family: .", "family: 👨\u200D👩\u200D👧", true},
+	{".", "👍🏽", true},
+	{"[!a-z]", "🇫🇷", true},
+
+	// A letter and its marks are a single character
+	{"e?", "e\u0301", false},
+	{"?", "e\u0301", true},
+	{"caf.", "cafe\u0301", true},
+	{"caf[a-z]", "cafe\u0301", true},
+	{"caf[!e]", "cafe\u0301", false},
+	{"cafe\u0301", "cafe\u0301", true},
+	{"cafe*", "cafe\u0301 au lait", false},
+	{"*\u0301", "cafe\u0301", false},
+	{"*e\u0301", "cafe\u0301", true},
+
+	// CR LF is a single line break
+	{"a.b", "a\r\nb", false},
+	{"a*b", "a\r\nb", true},
+	{"a?b", "a\r\nb", true},
+}
+
+// TestGraphemes validates the Graphemes option in both engines and in compiled programs
+func TestGraphemes(t *testing.T) {
+	opts := Options{Graphemes: true}
+	for _, c := range graphemeCases {
+		if got, err := MatchInternalWithOptions(c.pattern, c.input, opts); err != nil || got != c.want {
+			t.Errorf("MatchInternalWithOptions(%q, %q): expected %v, got %v, %v", c.pattern, c.input, c.want, got, err)
+		}
+		if got, err := MatchInternalFoldWithOptions(c.pattern, c.input, true, opts); err != nil || got != c.want {
+			t.Errorf("MatchInternalFoldWithOptions(%q, %q): expected %v, got %v, %v", c.pattern, c.input, c.want, got, err)
+		}
+		if got, err := MatchInternalFoldWithOptions([]byte(c.pattern), []byte(c.input), true, opts); err != nil || got != c.want {
+			t.Errorf("MatchInternalFoldWithOptions([]byte %q, %q): expected %v, got %v, %v", c.pattern, c.input, c.want, got, err)
+		}
+	}
+}
+
+// TestGraphemesFind validates that captures and search results never split a cluster
+func TestGraphemesFind(t *testing.T) {
+	prog, _ := CompileFold(".*", true, Options{Graphemes: true})
+	want := []int{0, 11, 0, 8, 8, 11} // 🇫🇷 is 8 bytes, é 3 bytes
+	if got := MatchProgramSubmatch(prog, "🇫🇷e\u0301"); !slices.Equal(got, want) {
+		t.Errorf("MatchProgramSubmatch: expected %v, got %v", want, got)
+	}
+
+	prog, _ = CompileFold(".", true, Options{Graphemes: true})
+	s := "🇫🇷e\u0301"
+	if got := FindAllProgram(prog, s, -1); !slices.EqualFunc(got, [][]int{{0, 8}, {8, 11}}, slices.Equal) {
+		t.Errorf("FindAllProgram(%+q): expected [[0 8] [8 11]], got %v", s, got)
+	}
+
+	prog, _ = CompileFold("e", true, Options{Graphemes: true})
+	s = "e\u0301 e"
+	if got := FindAllProgram(prog, s, -1); !slices.EqualFunc(got, [][]int{{4, 5}}, slices.Equal) {
+		t.Errorf("FindAllProgram(%+q): expected [[4 5]], got %v", s, got)
+	}
+}
@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+// Package wildcard contains optimized wildcard matching implementations.
+// This file provides Info, a summary of what every string matched by a Program
+// contains, read from its instructions: the literals outside brace groups and
+// the number of characters each instruction consumes.
+package wildcard
+
+import "unicode/utf8"
+
+// Info describes the strings matched by a Program, for indexing patterns
+// without running them. Lengths count the characters the program consumes:
+// bytes for byte-oriented programs and runes for Unicode-aware ones. Literals are
+// given unescaped; under case folding and the other options relaxing literal
+// comparison, the input contains text equal to them under those options rather
+// than their exact bytes.
+type Info struct {
+	Prefix   string   // Literal text every match starts with, "" if none
+	Suffix   string   // Literal text every match ends with, "" if none
+	Required []string // Literal fragments every match contains, in pattern order
+	MinLen   int      // Length of the shortest match
+	MaxLen   int      // Length of the longest match, -1 if unbounded
+	Literal  bool     // The pattern has no wildcards, classes or brace groups
+}
+
+// ProgramInfo returns the Info of p. Only literals outside brace groups are
+// reported, so a group such as `{ab,ac}` contributes to the lengths but not to
+// the prefix. A Program that matches nothing, such as one with an empty class,
+// still reports the lengths its instructions would consume.
+func ProgramInfo(p *Program) Info {
+	insts := p.insts
+	info := Info{Literal: true}
+
+	// Runs of consecutive literals outside brace groups are required fragments
+	var run []byte
+	flush := func() {
+		if len(run) > 0 {
+			info.Required = append(info.Required, string(run))
+			run = nil
+		}
+	}
+	for _, in := range insts {
+		if in.op != opLiteral || in.depth > 0 {
+			info.Literal = false
+			flush()
+			continue
+		}
+		run = append(run, in.lit...)
+	}
+	flush()
+
+	// The first and last fragments anchor the match when nothing precedes or
+	// follows them
+	if len(insts) > 0 && insts[0].op == opLiteral && insts[0].depth == 0 {
+		info.Prefix = info.Required[0]
+	}
+	if n := len(insts); n > 0 && insts[n-1].op == opLiteral && insts[n-1].depth == 0 {
+		info.Suffix = info.Required[len(info.Required)-1]
+	}
+
+	info.MinLen, info.MaxLen = p.span(0, len(insts))
+	return info
+}
+
+// span returns the least and greatest numbers of characters consumed by the
+// instructions from pc to end, with -1 for an unbounded maximum.
+func (p *Program) span(pc, end int) (lo, hi int) {
+	// Under these options a character or a literal may cover several runes
+	wide := p.graphemes || p.ignoreAccents || p.normalize
+	elastic := p.fullFold || p.normalize
+
+	add := func(min, max int) {
+		lo += min
+		if hi >= 0 {
+			hi = addMax(hi, max)
+		}
+	}
+	char := func(min, max int) {
+		if wide {
+			max = -1
+		}
+		add(min, max)
+	}
+
+	for pc < end {
+		in := &p.insts[pc]
+		switch in.op {
+		case opLiteral:
+			n := len(in.lit)
+			if p.unicode {
+				n = utf8.RuneCountInString(in.lit)
+			}
+			switch {
+			case elastic:
+				add(0, -1)
+			case wide:
+				add(n, -1)
+			default:
+				add(n, n)
+			}
+
+		case opDot, opAny, opClass:
+			char(1, 1)
+
+		case opQuestion:
+			char(in.min, in.n)
+
+		case opStar:
+			add(in.min, -1)
+
+		case opGlobstar:
+			add(0, -1)
+
+		case opAlt:
+			// The group spans as much as its shortest and longest alternatives
+			minAlt, maxAlt := -1, 0
+			for i, target := range in.alts {
+				stop := in.jump
+				if i+1 < len(in.alts) {
+					stop = in.alts[i+1] - 1 // The opJump ending the alternative
+				}
+				l, h := p.span(target, stop)
+				if minAlt < 0 || l < minAlt {
+					minAlt = l
+				}
+				if maxAlt >= 0 && (h < 0 || h > maxAlt) {
+					maxAlt = h
+				}
+			}
+			add(max(minAlt, 0), maxAlt)
+			pc = in.jump
+			continue
+		}
+		pc++
+	}
+	return lo, hi
+}
+
+// addMax adds two maximum lengths, either of which may be -1 for unbounded.
+func addMax(a, b int) int {
+	if a < 0 || b < 0 {
+		return -1
+	}
+	return a + b
+}
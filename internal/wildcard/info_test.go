@@ -0,0 +1,110 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package wildcard
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// TestProgramInfo validates the metadata of compiled patterns
+func TestProgramInfo(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opts    Options
+		want    Info
+	}{
+		{"", Options{}, Info{MinLen: 0, MaxLen: 0, Literal: true}},
+		{"hello", Options{}, Info{"hello", "hello", []string{"hello"}, 5, 5, true}},
+		{"a\\*b", Options{}, Info{"a*b", "a*b", []string{"a*b"}, 3, 3, true}},
+		{"admin-*", Options{}, Info{"admin-", "", []string{"admin-"}, 6, -1, false}},
+		{"*.txt", Options{LiteralDot: true}, Info{"", ".txt", []string{".txt"}, 4, -1, false}},
+		{"*.txt", Options{}, Info{"", "txt", []string{"txt"}, 4, -1, false}},
+		{"a*b?c", Options{}, Info{"a", "c", []string{"a", "b", "c"}, 3, -1, false}},
+		{"file??.log", Options{LiteralDot: true}, Info{"file", ".log", []string{"file", ".log"}, 8, 10, false}},
+		{"file??.log", Options{LiteralDot: true, StrictQuestion: true}, Info{"file", ".log", []string{"file", ".log"}, 10, 10, false}},
+		{"*?", Options{}, Info{"", "", nil, 0, -1, false}},
+		{"*?", Options{StrictQuestion: true}, Info{"", "", nil, 1, -1, false}},
+		{"[a-z][0-9]x", Options{}, Info{"", "x", []string{"x"}, 3, 3, false}},
+		{"img.{jpg,jpeg,}", Options{LiteralDot: true}, Info{"img.", "", []string{"img."}, 4, 8, false}},
+		{"{a,bc{d,ef}}-{x*,y}", Options{}, Info{"", "", []string{"-"}, 3, -1, false}},
+		{"src/**/main.go", Options{Separator: '/', LiteralDot: true}, Info{"src/", "main.go", []string{"src/", "main.go"}, 11, -1, false}},
+		{"café?", Options{}, Info{"café", "", []string{"café"}, 5, 6, false}},
+	}
+	for _, tc := range tests {
+		prog, err := Compile(tc.pattern, tc.opts)
+		if err != nil {
+			t.Fatalf("Compile(%q): %v", tc.pattern, err)
+		}
+		if got := ProgramInfo(prog); !infoEqual(got, tc.want) {
+			t.Errorf("ProgramInfo(%q, %+v) = %+v, expected %+v", tc.pattern, tc.opts, got, tc.want)
+		}
+	}
+
+	// Unicode-aware programs count runes
+	prog, _ := CompileFold("café?", true, Options{})
+	if got := ProgramInfo(prog); got.MinLen != 4 || got.MaxLen != 5 {
+		t.Errorf("ProgramInfo of a Unicode program: lengths %d, %d, expected 4, 5", got.MinLen, got.MaxLen)
+	}
+
+	// Characters of several runes leave the maximum unbounded
+	prog, _ = CompileFold("ab?", true, Options{Graphemes: true})
+	if got := ProgramInfo(prog); got.MinLen != 2 || got.MaxLen != -1 {
+		t.Errorf("ProgramInfo with graphemes: lengths %d, %d, expected 2, -1", got.MinLen, got.MaxLen)
+	}
+}
+
+// infoEqual reports whether two Info values are equal.
+func infoEqual(a, b Info) bool {
+	return a.Prefix == b.Prefix && a.Suffix == b.Suffix && slices.Equal(a.Required, b.Required) &&
+		a.MinLen == b.MinLen && a.MaxLen == b.MaxLen && a.Literal == b.Literal
+}
+
+// TestProgramInfoDifferential validates the metadata of random patterns against
+// the strings they match
+func TestProgramInfoDifferential(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 300; i++ {
+		pattern, opts := randomPattern(rng, 5), randomOpts(rng)
+		prog, err := CompileFold(pattern, false, opts)
+		if err != nil {
+			t.Fatalf("CompileFold(%q): %v", pattern, err)
+		}
+		info := ProgramInfo(prog)
+
+		// The shortest match has the minimum length
+		a, _ := newNFA(prog)
+		if d := a.distances()[0]; d >= 0 && d != info.MinLen {
+			t.Errorf("ProgramInfo(%q, %+v).MinLen = %d, expected %d", pattern, opts, info.MinLen, d)
+		}
+
+		examples, _ := Examples(prog, 20, rng)
+		for _, s := range examples {
+			n := utf8.RuneCountInString(s)
+			if n < info.MinLen || info.MaxLen >= 0 && n > info.MaxLen ||
+				!strings.HasPrefix(s, info.Prefix) || !strings.HasSuffix(s, info.Suffix) {
+				t.Errorf("ProgramInfo(%q, %+v) = %+v does not describe match %q", pattern, opts, info, s)
+			}
+			rest := s
+			for _, lit := range info.Required {
+				idx := strings.Index(rest, lit)
+				if idx < 0 {
+					t.Errorf("ProgramInfo(%q, %+v) requires %q, missing from match %q", pattern, opts, lit, s)
+					break
+				}
+				rest = rest[idx+len(lit):]
+			}
+		}
+	}
+}
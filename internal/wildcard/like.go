@@ -0,0 +1,231 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+// Package wildcard contains optimized wildcard matching implementations.
+// This file provides the SQL LIKE dialect: a compiler from LIKE patterns to the
+// same Program executed for wildcard patterns, and converters between the two
+// syntaxes for the patterns both can express.
+package wildcard
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrNotExpressible indicates a pattern has no equivalent in the target syntax.
+var ErrNotExpressible = errors.New("pattern not expressible in target syntax")
+
+const (
+	likeAny           = '%'  // Any sequence of characters
+	likeOne           = '_'  // Exactly one character
+	likeDefaultEscape = '\\' // Escape character unless configured otherwise
+)
+
+// CompileLike parses a SQL LIKE pattern into a Program: `%` matches any sequence
+// of characters and `_` exactly one character, newline included. Any other
+// character matches itself, and the escape character from opts makes the
+// character after it literal. Matching is rune-oriented, as in SQL, and
+// case-insensitive when fold is set (ILIKE).
+func CompileLike[T ~string | ~[]byte](pattern T, fold bool, opts Options) (*Program, error) {
+	prog := &Program{
+		unicode:       true,
+		fold:          fold,
+		fullFold:      fold && opts.FullFold,
+		turkic:        fold && opts.Turkic,
+		ignoreAccents: opts.IgnoreAccents,
+		graphemes:     opts.Graphemes,
+		normalize:     opts.Normalize,
+		invalid:       opts.InvalidUTF8,
+		sep:           opts.Separator,
+	}
+	if err := checkUTF8(pattern, prog.invalid); err != nil {
+		return nil, err
+	}
+	escape := likeEscape(opts)
+
+	var lit []byte
+	flush := func() {
+		if len(lit) > 0 {
+			prog.add(instr{op: opLiteral, lit: string(lit), litBytes: lit})
+			lit = nil
+		}
+	}
+
+	for pIdx := 0; pIdx < len(pattern); {
+		r, width := decodeRune(pattern, pIdx)
+		switch {
+		case r == escape:
+			if pIdx+width >= len(pattern) {
+				return nil, newPatternError(pattern, pIdx, pIdx+width, ReasonTrailingEscape)
+			}
+			_, next := decodeRune(pattern, pIdx+width)
+			lit = append(lit, pattern[pIdx+width:pIdx+width+next]...)
+			pIdx += width + next
+			continue
+
+		case r == likeAny:
+			// Runs of `%` collapse into a single star
+			flush()
+			prog.add(prog.star())
+			for pIdx < len(pattern) && pattern[pIdx] == likeAny {
+				pIdx++
+			}
+			continue
+
+		case r == likeOne:
+			flush()
+			prog.add(instr{op: opAny})
+
+		default:
+			lit = append(lit, pattern[pIdx:pIdx+width]...)
+		}
+		pIdx += width
+	}
+	flush()
+
+	return prog, nil
+}
+
+// likeEscape returns the LIKE escape character selected by opts, or -1 if
+// escaping is disabled.
+func likeEscape(opts Options) rune {
+	switch {
+	case opts.Escape == 0:
+		return likeDefaultEscape
+	case opts.Escape < 0:
+		return -1
+	default:
+		return opts.Escape
+	}
+}
+
+// ToLike converts a wildcard pattern into an equivalent LIKE pattern using
+// backslash as the escape character. `*` becomes `%` and literals are escaped as
+// needed. `?`, `.`, character classes, property escapes and brace groups have no
+// LIKE equivalent, except for `?` absorbed by an adjacent `*`, and are reported
+// as ErrNotExpressible.
+func ToLike(pattern string) (string, error) {
+	if err := ValidateFold(pattern); err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	for pIdx := 0; pIdx < len(pattern); {
+		r, width := utf8.DecodeRuneInString(pattern[pIdx:])
+		switch r {
+		case wildcardStar, wildcardQuestion:
+			end, stars := pIdx, 0
+			for end < len(pattern) && (pattern[end] == wildcardStar || pattern[end] == wildcardQuestion) {
+				if pattern[end] == wildcardStar {
+					stars++
+				}
+				end++
+			}
+			if stars == 0 {
+				return "", notExpressible(pattern, pIdx, end, "LIKE")
+			}
+			b.WriteByte(likeAny)
+			pIdx = end
+			continue
+
+		case wildcardDot, wildcardBracket, wildcardBrace:
+			return "", notExpressible(pattern, pIdx, pIdx+width, "LIKE")
+
+		case wildcardEscape:
+			if prop, end, _ := parseProperty(pattern, pIdx); prop != nil {
+				return "", notExpressible(pattern, pIdx, end, "LIKE")
+			}
+			if pIdx+width < len(pattern) {
+				// The escaped rune is a literal
+				pIdx += width
+				r, width = utf8.DecodeRuneInString(pattern[pIdx:])
+			}
+		}
+
+		if r == likeAny || r == likeOne || r == likeDefaultEscape {
+			b.WriteByte(likeDefaultEscape)
+		}
+		b.WriteString(pattern[pIdx : pIdx+width])
+		pIdx += width
+	}
+	return b.String(), nil
+}
+
+// FromLike converts a LIKE pattern, with the escape character selected by opts,
+// into an equivalent wildcard pattern for CompileFold. `%` becomes `*` and
+// literals are escaped as needed. `_` has no wildcard equivalent, since `.` does
+// not match newlines, and is reported as ErrNotExpressible.
+func FromLike(pattern string, opts Options) (string, error) {
+	escape := likeEscape(opts)
+
+	var b strings.Builder
+	for pIdx := 0; pIdx < len(pattern); {
+		r, width := utf8.DecodeRuneInString(pattern[pIdx:])
+		switch {
+		case r == escape:
+			if pIdx+width >= len(pattern) {
+				return "", newPatternError(pattern, pIdx, pIdx+width, ReasonTrailingEscape)
+			}
+			pIdx += width
+			r, width = utf8.DecodeRuneInString(pattern[pIdx:])
+
+		case r == likeAny:
+			b.WriteByte(wildcardStar)
+			for pIdx < len(pattern) && pattern[pIdx] == likeAny {
+				pIdx++
+			}
+			continue
+
+		case r == likeOne:
+			return "", notExpressible(pattern, pIdx, pIdx+width, "wildcard")
+		}
+
+		if r < utf8.RuneSelf && isWildcardTable[r] {
+			b.WriteByte(wildcardEscape)
+		}
+		b.WriteString(pattern[pIdx : pIdx+width])
+		pIdx += width
+	}
+	return b.String(), nil
+}
+
+// notExpressible returns an ErrNotExpressible error for the token pattern[start:end].
+func notExpressible(pattern string, start, end int, target string) error {
+	return &convertError{token: pattern[start:end], offset: start, pattern: pattern, target: target}
+}
+
+// notExpressibleOption returns an ErrNotExpressible error for a feature without
+// a position in pattern, such as a matching option.
+func notExpressibleOption(pattern, feature, target string) error {
+	return &convertError{token: feature, offset: -1, pattern: pattern, target: target}
+}
+
+// convertError reports the token that prevented a conversion between syntaxes.
+type convertError struct {
+	pattern string
+	offset  int // -1 if the token has no position in the pattern
+	token   string
+	target  string
+}
+
+func (e *convertError) Error() string {
+	msg := "pattern " + strconv.Quote(e.pattern) + " not expressible in " + e.target +
+		" syntax: " + strconv.Quote(e.token)
+	if e.offset < 0 {
+		return msg
+	}
+	return msg + " at offset " + strconv.Itoa(e.offset)
+}
+
+func (e *convertError) Unwrap() error {
+	return ErrNotExpressible
+}
@@ -0,0 +1,209 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package wildcard
+
+import (
+	"errors"
+	"testing"
+)
+
+// likeCases follow PostgreSQL LIKE semantics with the default backslash escape
+var likeCases = []struct {
+	pattern string
+	s       string
+	result  bool
+}{
+	{"", "", true},
+	{"", "a", false},
+	{"%", "", true},
+	{"%", "anything\nat all", true},
+	{"abc", "abc", true},
+	{"abc", "abcd", false},
+	{"a%", "abc", true},
+	{"%c", "abc", true},
+	{"%b%", "abc", true},
+	{"%%b%%", "abc", true},
+	{"_", "a", true},
+	{"_", "", false},
+	{"_", "ab", false},
+	{"_", "\n", true}, // Unlike `.`, `_` matches newline
+	{"_", "é", true},  // One character, not one byte
+	{"a_c", "abc", true},
+	{"a_c", "ac", false},
+	{"a__", "abc", true},
+	{"%_%", "", false},
+	{"%_%", "x", true},
+	{"100\\%", "100%", true},
+	{"100\\%", "1000", false},
+	{"a\\_b", "a_b", true},
+	{"a\\_b", "axb", false},
+	{"a\\\\b", "a\\b", true},
+	{"a\\b", "ab", true}, // Escaping an ordinary character is allowed
+	{"*?.[{", "*?.[{", true},
+	{"*", "abc", false}, // Wildcard syntax has no meaning in LIKE
+	{"Straße", "STRASSE", false},
+}
+
+// TestCompileLike validates LIKE matching for string and []byte input
+func TestCompileLike(t *testing.T) {
+	for _, c := range likeCases {
+		prog, err := CompileLike(c.pattern, false, Options{})
+		if err != nil {
+			t.Fatalf("CompileLike(%q) failed: %v", c.pattern, err)
+		}
+		if got := MatchProgram(prog, c.s); got != c.result {
+			t.Errorf("LIKE %q on %q: expected %v, got %v", c.pattern, c.s, c.result, got)
+		}
+		if got := MatchProgram(prog, []byte(c.s)); got != c.result {
+			t.Errorf("LIKE %q on []byte %q: expected %v, got %v", c.pattern, c.s, c.result, got)
+		}
+	}
+}
+
+// TestCompileILike validates case-insensitive LIKE matching
+func TestCompileILike(t *testing.T) {
+	tests := []struct {
+		pattern string
+		s       string
+		result  bool
+	}{
+		{"HELLO%", "hello world", true},
+		{"caf_", "CAFÉ", true},
+		{"%ÉCLAIR", "chocolate éclair", true},
+		{"a\\_B", "A_b", true},
+		{"a\\_B", "AxB", false},
+	}
+
+	for _, tt := range tests {
+		prog, err := CompileLike(tt.pattern, true, Options{})
+		if err != nil {
+			t.Fatalf("CompileLike(%q) failed: %v", tt.pattern, err)
+		}
+		if got := MatchProgram(prog, tt.s); got != tt.result {
+			t.Errorf("ILIKE %q on %q: expected %v, got %v", tt.pattern, tt.s, tt.result, got)
+		}
+	}
+
+	// Full case folding applies to ILIKE only
+	prog, _ := CompileLike("straße%", true, Options{FullFold: true})
+	if !MatchProgram(prog, "STRASSE-1") {
+		t.Errorf("ILIKE %q on %q with full folding: expected a match", "straße%", "STRASSE-1")
+	}
+	prog, _ = CompileLike("straße%", false, Options{FullFold: true})
+	if MatchProgram(prog, "strasse-1") {
+		t.Errorf("LIKE %q on %q with full folding: expected no match", "straße%", "strasse-1")
+	}
+}
+
+// TestCompileLikeEscape validates custom and disabled escape characters
+func TestCompileLikeEscape(t *testing.T) {
+	tests := []struct {
+		pattern string
+		escape  rune
+		s       string
+		result  bool
+	}{
+		{"100!%", '!', "100%", true},
+		{"100!%", '!', "1000", false},
+		{"a\\%", '!', "a\\bc", true}, // Backslash is ordinary with another escape
+		{"a!!b", '!', "a!b", true},
+		{"a→_b", '→', "a_b", true},
+		{"a\\%", -1, "a\\bc", true}, // ESCAPE ''
+		{"a\\", -1, "a\\", true},
+	}
+
+	for _, tt := range tests {
+		prog, err := CompileLike(tt.pattern, false, Options{Escape: tt.escape})
+		if err != nil {
+			t.Fatalf("CompileLike(%q, %q) failed: %v", tt.pattern, tt.escape, err)
+		}
+		if got := MatchProgram(prog, tt.s); got != tt.result {
+			t.Errorf("LIKE %q ESCAPE %q on %q: expected %v, got %v", tt.pattern, tt.escape, tt.s, tt.result, got)
+		}
+	}
+
+	// A trailing escape character is an error, as in PostgreSQL
+	for _, pattern := range []string{"abc\\", "\\"} {
+		_, err := CompileLike(pattern, false, Options{})
+		var pe *PatternError
+		if !errors.As(err, &pe) || pe.Reason != ReasonTrailingEscape {
+			t.Errorf("CompileLike(%q): expected trailing escape error, got %v", pattern, err)
+		}
+	}
+}
+
+// TestLikeConversion validates conversions between wildcard and LIKE syntax
+func TestLikeConversion(t *testing.T) {
+	toLike := []struct {
+		pattern string
+		like    string
+	}{
+		{"", ""},
+		{"abc", "abc"},
+		{"*\\.txt", "%.txt"},
+		{"a*?b", "a%b"},
+		{"100%_x", "100\\%\\_x"},
+		{"\\*\\?\\.\\[", "*?.["},
+		{"a\\\\b", "a\\\\b"},
+		{"a\\", "a\\\\"},
+		{"a,b}", "a,b}"},
+	}
+	for _, tt := range toLike {
+		got, err := ToLike(tt.pattern)
+		if err != nil || got != tt.like {
+			t.Errorf("ToLike(%q): expected %q, got %q (err %v)", tt.pattern, tt.like, got, err)
+		}
+	}
+
+	for _, pattern := range []string{"a?b", "file.txt", "[abc]", "*.{jpg,png}"} {
+		if _, err := ToLike(pattern); !errors.Is(err, ErrNotExpressible) {
+			t.Errorf("ToLike(%q): expected ErrNotExpressible, got %v", pattern, err)
+		}
+	}
+	if _, err := ToLike("abc["); !errors.Is(err, ErrBadPattern) {
+		t.Errorf("ToLike(%q): expected ErrBadPattern, got %v", "abc[", err)
+	}
+
+	fromLike := []struct {
+		like    string
+		escape  rune
+		pattern string
+	}{
+		{"abc", 0, "abc"},
+		{"%.txt", 0, "*\\.txt"},
+		{"a%%b", 0, "a*b"},
+		{"100\\%", 0, "100%"},
+		{"*?[{\\\\", 0, "\\*\\?\\[\\{\\\\"},
+		{"100!%", '!', "100%"},
+	}
+	for _, tt := range fromLike {
+		got, err := FromLike(tt.like, Options{Escape: tt.escape})
+		if err != nil || got != tt.pattern {
+			t.Errorf("FromLike(%q): expected %q, got %q (err %v)", tt.like, tt.pattern, got, err)
+		}
+	}
+
+	if _, err := FromLike("a_b", Options{}); !errors.Is(err, ErrNotExpressible) {
+		t.Errorf("FromLike(%q): expected ErrNotExpressible, got %v", "a_b", err)
+	}
+
+	// Converted patterns match the same inputs as the originals
+	for _, c := range likeCases {
+		pattern, err := FromLike(c.pattern, Options{})
+		if err != nil {
+			continue
+		}
+		prog, _ := CompileFold(pattern, false, Options{})
+		if got := MatchProgram(prog, c.s); got != c.result {
+			t.Errorf("FromLike(%q) = %q on %q: expected %v, got %v", c.pattern, pattern, c.s, c.result, got)
+		}
+	}
+}
@@ -26,13 +26,18 @@ var ErrBadPattern = errors.New("syntax error in pattern")
 
 const (
 	// All supported wildcard characters
-	WildcardChars = "*?.[\\"
+	WildcardChars = "*?.[{\\"
 	// Individual wildcard constants
 	wildcardStar     = '*'
 	wildcardQuestion = '?'
 	wildcardDot      = '.'
 	wildcardBracket  = '['
+	wildcardBrace    = '{'
 	wildcardEscape   = '\\'
+
+	// Brace group separators, only special inside `{...}`
+	braceComma = ','
+	braceClose = '}'
 )
 
 // Lookup table for fast wildcard detection - initialized at compile time
@@ -41,6 +46,7 @@ var isWildcardTable = [256]bool{
 	'?':  true,
 	'.':  true,
 	'[':  true,
+	'{':  true,
 	'\\': true,
 }
 
@@ -59,8 +65,9 @@ type charRange struct {
 // ASCII-only character class for maximum performance
 type charClass struct {
 	Negated bool
-	Chars   []byte      // Individual ASCII characters
-	Ranges  []charRange // ASCII character ranges
+	Chars   []byte        // Individual ASCII characters
+	Ranges  []charRange   // ASCII character ranges
+	Classes []*posixClass // POSIX named classes such as [:alpha:]
 }
 
 // matches checks if the given ASCII byte matches this character class
@@ -78,6 +85,16 @@ func (cc *charClass) matches(char byte) bool {
 		}
 	}
 
+	// Named classes use their ASCII definitions
+	if !matched {
+		for _, pc := range cc.Classes {
+			if pc.ascii(char) {
+				matched = true
+				break
+			}
+		}
+	}
+
 	// Apply negation if needed
 	if cc.Negated {
 		matched = !matched
@@ -94,14 +111,17 @@ func (cc *charClass) matches(char byte) bool {
 //
 // Returns the parsed charClass, the new position after the class, and any error.
 // For Unicode character class support, use NewCharClass in match_fold.go.
+// Property escapes are not recognized: patterns containing them are always
+// compiled to Unicode programs, whose classes are parsed by NewcharClassFold.
 func NewCharClass[T ~string | ~[]byte](pattern T, pi int) (*charClass, int, error) {
 	if pi >= len(pattern) || pattern[pi] != wildcardBracket {
-		return nil, pi, ErrBadPattern
+		return nil, pi, newPatternError(pattern, pi, pi+1, ReasonExpectedClass)
 	}
 
+	start := pi
 	pi++ // Skip the opening wildcardBracket
 	if pi >= len(pattern) {
-		return nil, pi, ErrBadPattern
+		return nil, pi, newPatternError(pattern, start, start+1, ReasonUnclosedClass)
 	}
 
 	cc := &charClass{}
@@ -111,7 +131,7 @@ func NewCharClass[T ~string | ~[]byte](pattern T, pi int) (*charClass, int, erro
 		cc.Negated = true
 		pi++
 		if pi >= len(pattern) {
-			return nil, pi, ErrBadPattern
+			return nil, pi, newPatternError(pattern, start, start+1, ReasonUnclosedClass)
 		}
 	}
 
@@ -127,12 +147,24 @@ func NewCharClass[T ~string | ~[]byte](pattern T, pi int) (*charClass, int, erro
 		}
 		firstChar = false
 
+		// Named class such as [:alpha:]
+		pc, end, err := parsePosixClass(pattern, pi)
+		if err != nil {
+			return nil, pi, err
+		}
+		if pc != nil {
+			cc.Classes = append(cc.Classes, pc)
+			pi = end
+			continue
+		}
+
 		// Handle escape sequences and character reading
 		var c1 byte
+		c1Start := pi
 		if pattern[pi] == wildcardEscape {
 			pi++ // Skip the backslash
 			if pi >= len(pattern) {
-				return nil, pi, ErrBadPattern
+				return nil, pi, newPatternError(pattern, pi-1, pi, ReasonDanglingEscape)
 			}
 			// The escaped character is treated as a literal byte
 			c1 = pattern[pi]
@@ -153,12 +185,12 @@ func NewCharClass[T ~string | ~[]byte](pattern T, pi int) (*charClass, int, erro
 				// Handle escape in range end
 				var c2 byte
 				if pi >= len(pattern) {
-					return nil, pi, ErrBadPattern
+					return nil, pi, newPatternError(pattern, start, start+1, ReasonUnclosedClass)
 				}
 				if pattern[pi] == wildcardEscape {
 					pi++ // Skip the backslash
 					if pi >= len(pattern) {
-						return nil, pi, ErrBadPattern
+						return nil, pi, newPatternError(pattern, pi-1, pi, ReasonDanglingEscape)
 					}
 					c2 = pattern[pi]
 					pi++
@@ -169,7 +201,7 @@ func NewCharClass[T ~string | ~[]byte](pattern T, pi int) (*charClass, int, erro
 
 				// Validate range
 				if c1 > c2 {
-					return nil, pi, ErrBadPattern // Invalid range like [z-a]
+					return nil, pi, newPatternError(pattern, c1Start, pi, ReasonInvertedRange) // Invalid range like [z-a]
 				}
 				// Add range
 				cc.Ranges = append(cc.Ranges, charRange{Start: c1, End: c2})
@@ -185,7 +217,7 @@ func NewCharClass[T ~string | ~[]byte](pattern T, pi int) (*charClass, int, erro
 
 	// Check if character class was properly closed
 	if !closed {
-		return nil, pi, ErrBadPattern
+		return nil, pi, newPatternError(pattern, start, start+1, ReasonUnclosedClass)
 	}
 
 	return cc, pi, nil
@@ -208,11 +240,20 @@ func NewCharClass[T ~string | ~[]byte](pattern T, pi int) (*charClass, int, erro
 //   - `?`: Matches zero or one character (with backtracking for both options)
 //   - `.`: Matches any single character except newline
 //   - `[abc]`: ASCII-only character classes
+//   - `{a,b}`: Brace alternatives, possibly nested (matched by the compiled engine)
 //   - `\x`: Escape sequences for literal characters
 //
 // For Unicode support and case-insensitive matching, use MatchInternalFold instead.
 // This provides 2-5x performance improvement over Unicode-aware matching for ASCII input.
 func MatchInternal[T ~string | ~[]byte](pattern, s T) (bool, error) {
+	return MatchInternalWithOptions(pattern, s, Options{})
+}
+
+// MatchInternalWithOptions is MatchInternal with the semantics adjusted by opts.
+// StrictQuestion and LiteralDot are handled by the backtracking loop itself, while
+// a separator makes the pattern go through the compiled engine, like brace groups.
+// Results always agree with MatchProgram on the compiled pattern.
+func MatchInternalWithOptions[T ~string | ~[]byte](pattern, s T, opts Options) (bool, error) {
 	pLen, sLen := len(pattern), len(s)
 
 	// Do type assertion once at the start for performance
@@ -230,17 +271,42 @@ func MatchInternal[T ~string | ~[]byte](pattern, s T) (bool, error) {
 		sBytes = any(s).([]byte)
 	}
 
-	pIdx, sIdx := 0, 0
+	// Path-aware, accent-insensitive, grapheme-aware and normalization-insensitive
+	// matching are only implemented by the compiled engine
+	if opts.Separator != 0 || opts.IgnoreAccents || opts.Graphemes || opts.Normalize {
+		prog, err := Compile(pattern, opts)
+		if err != nil {
+			return false, err
+		}
+		return matchCompiled(prog, s)
+	}
+
+	// Malformed patterns are reported up front, whatever the input. Only character
+	// classes, brace groups and property escapes can be malformed.
+	if hasSyntax(pattern) {
+		// Property escapes need whole runes, so they are matched by the compiled
+		// engine, which decodes the input as UTF-8 for such patterns. Brace groups
+		// are matched by it too, their alternatives sharing the rest of the pattern
+		// instead of being expanded into separate patterns. Compiling reports any
+		// malformed part of such patterns.
+		if hasProperty(pattern) || isString && strings.IndexByte(pStr, wildcardBrace) >= 0 || !isString && bytes.IndexByte(pBytes, wildcardBrace) >= 0 {
+			prog, err := Compile(pattern, opts)
+			if err != nil {
+				return false, err
+			}
+			return matchCompiled(prog, s)
+		}
+
+		if err := checkClasses(pattern, false); err != nil {
+			return false, err
+		}
+	}
 
-	// Optimized backtracking: simple state tracking for both wildcards
-	starIdx, sTmpIdx := -1, -1     // For * wildcard backtracking
-	questionIdx, qTmpIdx := -1, -1 // For ? wildcard backtracking
-	qCount, qMatched := 0, 0       // Track ? wildcard limits
+	pIdx, sIdx := 0, 0
 
-	// Star optimization: store literal sequence after * for index-based search
-	var starLiteral string
-	var starLiteralBytes []byte
-	hasStarLiteral := false
+	// Save points of the last star and of the runs of optional ? still to retry
+	var bt backtracker
+	bt.init(isString, pStr, sStr, pBytes, sBytes, false)
 
 	for {
 		// Check for success: both pattern and string fully consumed
@@ -251,29 +317,37 @@ func MatchInternal[T ~string | ~[]byte](pattern, s T) (bool, error) {
 		// Case 1: `*` wildcard. Optimize consecutive stars and absorb ? wildcards.
 		if pIdx < pLen && pattern[pIdx] == wildcardStar {
 			// Skip all consecutive * and ? wildcards - * absorbs ? capabilities
+			run := pIdx
+			questions := 0
 			for pIdx < pLen && (pattern[pIdx] == wildcardStar || pattern[pIdx] == wildcardQuestion) {
+				if pattern[pIdx] == wildcardQuestion {
+					questions++
+				}
 				pIdx++
 			}
-			// Save the position after all absorbed wildcards for backtracking
-			starIdx = pIdx
-			sTmpIdx = sIdx
-
-			// Extract literal sequence after star for optimization
-			hasStarLiteral = false
-			if starIdx < pLen && !IsWildcardByte(pattern[starIdx]) {
-				// Find end of literal sequence
-				literalEnd := starIdx
+			if opts.StrictQuestion {
+				// Each absorbed `?` still consumes exactly one character. Retrying an
+				// earlier star only leaves less input, so a shortfall is final.
+				if sLen-sIdx < questions {
+					return false, nil
+				}
+				sIdx += questions
+			}
+			// Extract the literal sequence after the star for optimization
+			literalEnd := pIdx
+			if pIdx < pLen && !IsWildcardByte(pattern[pIdx]) {
 				for literalEnd < pLen && !IsWildcardByte(pattern[literalEnd]) {
 					literalEnd++
 				}
+			}
 
-				// Store the literal for fast search during backtracking
-				if isString {
-					starLiteral = pStr[starIdx:literalEnd]
-				} else {
-					starLiteralBytes = pBytes[starIdx:literalEnd]
+			// Save the position after all absorbed wildcards for backtracking,
+			// unless the rest of the pattern is already known to fail from here
+			if !bt.enterStar(run, pIdx, literalEnd, sIdx) {
+				var ok bool
+				if pIdx, sIdx, ok = bt.next(); !ok {
+					return backtrackFailed(&bt, pattern, s, false, opts)
 				}
-				hasStarLiteral = true
 			}
 			continue
 		}
@@ -281,18 +355,26 @@ func MatchInternal[T ~string | ~[]byte](pattern, s T) (bool, error) {
 		// Case 2: `?` wildcard. Optimize consecutive ? wildcards and save state.
 		if pIdx < pLen && pattern[pIdx] == wildcardQuestion {
 			// Count and skip all consecutive ? wildcards
-			qCount = 0
+			qCount := 0
 			for pIdx < pLen && pattern[pIdx] == wildcardQuestion {
 				qCount++
 				pIdx++
 			}
 
-			// Save state for backtracking with question count limit
-			questionIdx = pIdx
-			qTmpIdx = sIdx
-			qMatched = 0 // Reset matched count
+			if opts.StrictQuestion {
+				// Each `?` consumes exactly one character, so there is no state to save
+				if sLen-sIdx < qCount {
+					return false, nil
+				}
+				sIdx += qCount
+				continue
+			}
 
-			// Try matching zero characters first (greedy approach - match as few as possible)
+			// Save state for backtracking with question count limit, then try
+			// matching zero characters first (match as few as possible)
+			if !bt.enterQuestion(pIdx, sIdx, qCount) {
+				return backtrackFailed(&bt, pattern, s, false, opts)
+			}
 			continue
 		}
 
@@ -334,7 +416,7 @@ func MatchInternal[T ~string | ~[]byte](pattern, s T) (bool, error) {
 				}
 			}
 			// Escaped character doesn't match, fall through to backtrack
-		} else if pIdx < pLen && pattern[pIdx] == wildcardDot {
+		} else if pIdx < pLen && pattern[pIdx] == wildcardDot && !opts.LiteralDot {
 			// `.` matches any single character except newline
 			if sIdx >= sLen {
 				// No character available, fall through to backtrack
@@ -369,49 +451,11 @@ func MatchInternal[T ~string | ~[]byte](pattern, s T) (bool, error) {
 			}
 		}
 
-		// Case 4: Mismatch or end of pattern. We must backtrack.
-		// First, try ? wildcard backtracking (most recent decisions)
-		if questionIdx != -1 && qTmpIdx < sLen && qMatched < qCount {
-			// Try matching one more character with ? and retry (ASCII - single byte)
-			qTmpIdx++
-			qMatched++
-			pIdx = questionIdx
-			sIdx = qTmpIdx
-			continue
+		// Case 4: Mismatch or end of pattern. We must backtrack, retrying the most
+		// recent `?` or star decision that has any choice left.
+		var ok bool
+		if pIdx, sIdx, ok = bt.next(); !ok {
+			return backtrackFailed(&bt, pattern, s, false, opts)
 		}
-
-		// If ? backtracking exhausted, try * wildcard backtracking
-		if starIdx != -1 && sTmpIdx < sLen {
-			// Reset ? state since we're trying a different path
-			questionIdx, qTmpIdx = -1, -1
-			qCount, qMatched = 0, 0
-			pIdx = starIdx
-
-			// Optimize: use index-based search if we have a literal after *
-			if hasStarLiteral {
-				// Find next occurrence of the literal sequence
-				var nextPos int
-				if isString {
-					nextPos = strings.Index(sStr[sTmpIdx+1:], starLiteral)
-				} else {
-					nextPos = bytes.Index(sBytes[sTmpIdx+1:], starLiteralBytes)
-				}
-
-				if nextPos == -1 {
-					// No more occurrences of the literal - match fails
-					return false, nil
-				}
-				sTmpIdx += nextPos + 1
-			} else {
-				// Fall back to incremental advancement (ASCII - single byte)
-				sTmpIdx++
-			}
-
-			sIdx = sTmpIdx
-			continue
-		}
-
-		// No backtracking options left.
-		return false, nil
 	}
 }
@@ -39,23 +39,44 @@ type charClassFold struct {
 	Negated bool
 	Chars   []rune          // Individual characters
 	Ranges  []charRangeFold // Character ranges
+	Classes []*posixClass   // POSIX named classes such as [:alpha:]
+	Props   []*property     // Unicode property escapes such as \p{L}
 }
 
 // MatchesWithFold checks if the given rune matches this character class.
 // Note: Character classes are always case-sensitive, regardless of the fold parameter.
 // This maintains compatibility with standard glob behavior where [a-z] should not match 'A'.
+// Use matchesFolded for the opt-in case-insensitive behaviour.
 func (cc *charClassFold) MatchesWithFold(char rune, fold bool) bool {
 	// Character classes are always case-sensitive
-	matched := slices.Contains(cc.Chars, char)
+	matched := cc.contains(char)
 
-	// Check ranges if not matched yet
-	if !matched {
-		matched = slices.ContainsFunc(cc.Ranges, func(r charRangeFold) bool {
-			return char >= r.Start && char <= r.End
-		})
+	// Apply negation if needed
+	if cc.Negated {
+		matched = !matched
 	}
 
-	// Apply negation if needed
+	return matched
+}
+
+// matchesFolded checks if the given rune matches this character class using
+// Unicode simple folding: the rune matches if any of its case variants is a member.
+// Only the SimpleFold orbit of the rune is visited, so ranges are never expanded
+// and `[a-z]` matches 'K' (KELVIN SIGN) through its variant 'k'. With turkic set,
+// the dotted and dotless I letters only match through their Turkic pair.
+func (cc *charClassFold) matchesFolded(char rune, turkic bool) bool {
+	matched := cc.contains(char)
+	if _, special := turkicFold(char); turkic && special {
+		if !matched {
+			matched = cc.contains(turkicPair(char))
+		}
+	} else {
+		for f := unicode.SimpleFold(char); !matched && f != char; f = unicode.SimpleFold(f) {
+			matched = cc.contains(f)
+		}
+	}
+
+	// Negation applies to the folded class, so [!a-z] rejects 'A' too
 	if cc.Negated {
 		matched = !matched
 	}
@@ -63,9 +84,41 @@ func (cc *charClassFold) MatchesWithFold(char rune, fold bool) bool {
 	return matched
 }
 
+// contains reports whether char is a member of the class, before negation.
+func (cc *charClassFold) contains(char rune) bool {
+	if slices.Contains(cc.Chars, char) {
+		return true
+	}
+
+	// Check ranges
+	if slices.ContainsFunc(cc.Ranges, func(r charRangeFold) bool {
+		return char >= r.Start && char <= r.End
+	}) {
+		return true
+	}
+
+	// Named classes use their Unicode definitions
+	if slices.ContainsFunc(cc.Classes, func(pc *posixClass) bool {
+		return pc.unicode(char)
+	}) {
+		return true
+	}
+
+	// Property escapes
+	return slices.ContainsFunc(cc.Props, func(p *property) bool {
+		return p.matches(char)
+	})
+}
+
 // NewcharClassFold creates a new charClassFold by parsing the pattern at the given position.
 // Returns the parsed charClassFold, the new position after the class, and any error.
 func NewcharClassFold[T ~string | ~[]byte](pattern T, pi int) (*charClassFold, int, error) {
+	return parseCharClassFold(pattern, pi, false)
+}
+
+// parseCharClassFold implements NewcharClassFold. With opaque set, invalid bytes
+// in the class stand for themselves, as under UTF8Opaque, instead of U+FFFD.
+func parseCharClassFold[T ~string | ~[]byte](pattern T, pi int, opaque bool) (*charClassFold, int, error) {
 	// Use proper UTF-8 decoding for consistent behavior
 	var isString bool
 	var pStr string
@@ -85,27 +138,28 @@ func NewcharClassFold[T ~string | ~[]byte](pattern T, pi int) (*charClassFold, i
 			if pos >= len(pStr) {
 				return 0, 0
 			}
-			return utf8.DecodeRuneInString(pStr[pos:])
+			return decodeChar(pStr, pos, opaque)
 		} else {
 			if pos >= len(pBytes) {
 				return 0, 0
 			}
-			return utf8.DecodeRune(pBytes[pos:])
+			return decodeChar(pBytes, pos, opaque)
 		}
 	}
 
 	if pi >= len(pattern) {
-		return nil, pi, ErrBadPattern
+		return nil, pi, newPatternError(pattern, pi, pi+1, ReasonExpectedClass)
 	}
 
 	r, width := decodeRune(pi)
 	if r != wildcardBracket {
-		return nil, pi, ErrBadPattern
+		return nil, pi, newPatternError(pattern, pi, pi+width, ReasonExpectedClass)
 	}
 
+	start := pi
 	pi += width // Skip the opening '['
 	if pi >= len(pattern) {
-		return nil, pi, ErrBadPattern
+		return nil, pi, newPatternError(pattern, start, start+1, ReasonUnclosedClass)
 	}
 
 	cc := &charClassFold{}
@@ -117,7 +171,7 @@ func NewcharClassFold[T ~string | ~[]byte](pattern T, pi int) (*charClassFold, i
 			cc.Negated = true
 			pi += width
 			if pi >= len(pattern) {
-				return nil, pi, ErrBadPattern
+				return nil, pi, newPatternError(pattern, start, start+1, ReasonUnclosedClass)
 			}
 		}
 	}
@@ -135,12 +189,35 @@ func NewcharClassFold[T ~string | ~[]byte](pattern T, pi int) (*charClassFold, i
 		}
 		firstChar = false
 
+		// Named class such as [:alpha:]
+		pc, end, err := parsePosixClass(pattern, pi)
+		if err != nil {
+			return nil, pi, err
+		}
+		if pc != nil {
+			cc.Classes = append(cc.Classes, pc)
+			pi = end
+			continue
+		}
+
+		// Property escape such as \p{L}
+		prop, end, err := parseProperty(pattern, pi)
+		if err != nil {
+			return nil, pi, err
+		}
+		if prop != nil {
+			cc.Props = append(cc.Props, prop)
+			pi = end
+			continue
+		}
+
 		// Handle escape sequences and character reading
 		var c1 rune
+		c1Start := pi
 		if r == '\\' {
 			pi += width // Skip the backslash
 			if pi >= len(pattern) {
-				return nil, pi, ErrBadPattern
+				return nil, pi, newPatternError(pattern, pi-width, pi, ReasonDanglingEscape)
 			}
 			// The escaped character is treated as a literal rune
 			r2, width2 := decodeRune(pi)
@@ -165,13 +242,13 @@ func NewcharClassFold[T ~string | ~[]byte](pattern T, pi int) (*charClassFold, i
 					// Handle escape in range end
 					var c2 rune
 					if pi >= len(pattern) {
-						return nil, pi, ErrBadPattern
+						return nil, pi, newPatternError(pattern, start, start+1, ReasonUnclosedClass)
 					}
 					r3, width3 := decodeRune(pi)
 					if r3 == '\\' {
 						pi += width3 // Skip the backslash
 						if pi >= len(pattern) {
-							return nil, pi, ErrBadPattern
+							return nil, pi, newPatternError(pattern, pi-width3, pi, ReasonDanglingEscape)
 						}
 						r4, width4 := decodeRune(pi)
 						c2 = r4
@@ -183,7 +260,7 @@ func NewcharClassFold[T ~string | ~[]byte](pattern T, pi int) (*charClassFold, i
 
 					// Validate range
 					if c1 > c2 {
-						return nil, pi, ErrBadPattern // Invalid range like [z-a]
+						return nil, pi, newPatternError(pattern, c1Start, pi, ReasonInvertedRange) // Invalid range like [z-a]
 					}
 					// Add range
 					cc.Ranges = append(cc.Ranges, charRangeFold{Start: c1, End: c2})
@@ -203,7 +280,7 @@ func NewcharClassFold[T ~string | ~[]byte](pattern T, pi int) (*charClassFold, i
 
 	// Check if character class was properly closed
 	if !closed {
-		return nil, pi, ErrBadPattern
+		return nil, pi, newPatternError(pattern, start, start+1, ReasonUnclosedClass)
 	}
 
 	return cc, pi, nil
@@ -245,6 +322,7 @@ func equalFoldRune(r1, r2 rune) bool {
 //   - `?`: Matches zero or one character (with backtracking for both options)
 //   - `.`: Matches any single character except newline
 //   - `[abc]`: Character classes with full Unicode support (always case-sensitive)
+//   - `{a,b}`: Brace alternatives, possibly nested (matched by the compiled engine)
 //   - `\x`: Escape sequences for literal characters
 //
 // The fold parameter controls case-insensitive matching using Unicode simple folding.
@@ -254,6 +332,12 @@ func equalFoldRune(r1, r2 rune) bool {
 // For ASCII-only input, consider using the optimized MatchInternal function in match.go
 // for 2-5x better performance.
 func MatchInternalFold[T ~string | ~[]byte](pattern, s T, fold bool) (bool, error) {
+	return MatchInternalFoldWithOptions(pattern, s, fold, Options{})
+}
+
+// MatchInternalFoldWithOptions is MatchInternalFold with the semantics adjusted by
+// opts, in the same way as MatchInternalWithOptions.
+func MatchInternalFoldWithOptions[T ~string | ~[]byte](pattern, s T, fold bool, opts Options) (bool, error) {
 	pLen, sLen := len(pattern), len(s)
 
 	// Do type assertion once at the start for performance
@@ -271,17 +355,42 @@ func MatchInternalFold[T ~string | ~[]byte](pattern, s T, fold bool) (bool, erro
 		sBytes = any(s).([]byte)
 	}
 
-	pIdx, sIdx := 0, 0
+	// Path-aware, accent-insensitive, grapheme-aware and normalization-insensitive
+	// matching, full case folding, Turkic casing and invalid UTF-8 policies are
+	// only implemented by the compiled engine, which compares literals as a whole
+	if opts.Separator != 0 || opts.IgnoreAccents || opts.Graphemes || opts.Normalize || opts.InvalidUTF8 != UTF8Replace ||
+		fold && (opts.FullFold || opts.Turkic) {
+		prog, err := CompileFold(pattern, fold, opts)
+		if err != nil {
+			return false, err
+		}
+		return matchCompiled(prog, s)
+	}
+
+	// Malformed patterns are reported up front, whatever the input. Only character
+	// classes, brace groups and property escapes can be malformed.
+	if hasSyntax(pattern) {
+		// Brace groups are matched by the compiled engine, whose alternatives share
+		// the rest of the pattern instead of being expanded into separate patterns.
+		// Compiling reports any malformed part of such patterns.
+		if isString && strings.IndexByte(pStr, wildcardBrace) >= 0 || !isString && bytes.IndexByte(pBytes, wildcardBrace) >= 0 {
+			prog, err := CompileFold(pattern, fold, opts)
+			if err != nil {
+				return false, err
+			}
+			return matchCompiled(prog, s)
+		}
+
+		if err := checkClasses(pattern, true); err != nil {
+			return false, err
+		}
+	}
 
-	// Optimized backtracking: simple state tracking for both wildcards
-	starIdx, sTmpIdx := -1, -1     // For * wildcard backtracking
-	questionIdx, qTmpIdx := -1, -1 // For ? wildcard backtracking
-	qCount, qMatched := 0, 0       // Track ? wildcard limits
+	pIdx, sIdx := 0, 0
 
-	// Star optimization: store literal sequence after * for index-based search
-	var starLiteral string
-	var starLiteralBytes []byte
-	hasStarLiteral := false
+	// Save points of the last star and of the runs of optional ? still to retry
+	var bt backtracker
+	bt.init(isString, pStr, sStr, pBytes, sBytes, true)
 
 	for { // The loop continues as long as there are characters to match or states to backtrack to.
 		// Check for success: both pattern and string fully consumed
@@ -292,29 +401,37 @@ func MatchInternalFold[T ~string | ~[]byte](pattern, s T, fold bool) (bool, erro
 		// Case 1: `*` wildcard. Optimize consecutive stars and absorb ? wildcards.
 		if pIdx < pLen && pattern[pIdx] == wildcardStar {
 			// Skip all consecutive * and ? wildcards - * absorbs ? capabilities
+			run := pIdx
+			questions := 0
 			for pIdx < pLen && (pattern[pIdx] == wildcardStar || pattern[pIdx] == wildcardQuestion) {
+				if pattern[pIdx] == wildcardQuestion {
+					questions++
+				}
 				pIdx++
 			}
-			// Save the position after all absorbed wildcards for backtracking
-			starIdx = pIdx
-			sTmpIdx = sIdx
-
-			// Extract literal sequence after star for optimization (only for case-sensitive)
-			hasStarLiteral = false
-			if !fold && starIdx < pLen && !IsWildcardByte(pattern[starIdx]) {
-				// Find end of literal sequence
-				literalEnd := starIdx
+			if opts.StrictQuestion {
+				// Each absorbed `?` still consumes exactly one character. Retrying an
+				// earlier star only leaves less input, so a shortfall is final.
+				var ok bool
+				if sIdx, ok = skipRunes(s, sIdx, questions); !ok {
+					return false, nil
+				}
+			}
+			// Extract the literal sequence after the star for optimization (only for case-sensitive)
+			literalEnd := pIdx
+			if !fold && pIdx < pLen && !IsWildcardByte(pattern[pIdx]) {
 				for literalEnd < pLen && !IsWildcardByte(pattern[literalEnd]) {
 					literalEnd++
 				}
+			}
 
-				// Store the literal for fast search during backtracking
-				if isString {
-					starLiteral = pStr[starIdx:literalEnd]
-				} else {
-					starLiteralBytes = pBytes[starIdx:literalEnd]
+			// Save the position after all absorbed wildcards for backtracking,
+			// unless the rest of the pattern is already known to fail from here
+			if !bt.enterStar(run, pIdx, literalEnd, sIdx) {
+				var ok bool
+				if pIdx, sIdx, ok = bt.next(); !ok {
+					return backtrackFailed(&bt, pattern, s, fold, opts)
 				}
-				hasStarLiteral = true
 			}
 			continue
 		}
@@ -322,18 +439,26 @@ func MatchInternalFold[T ~string | ~[]byte](pattern, s T, fold bool) (bool, erro
 		// Case 2: `?` wildcard. Optimize consecutive ? wildcards and save state.
 		if pIdx < pLen && pattern[pIdx] == wildcardQuestion {
 			// Count and skip all consecutive ? wildcards
-			qCount = 0
+			qCount := 0
 			for pIdx < pLen && pattern[pIdx] == wildcardQuestion {
 				qCount++
 				pIdx++
 			}
 
-			// Save state for backtracking with question count limit
-			questionIdx = pIdx
-			qTmpIdx = sIdx
-			qMatched = 0 // Reset matched count
+			if opts.StrictQuestion {
+				// Each `?` consumes exactly one character, so there is no state to save
+				var ok bool
+				if sIdx, ok = skipRunes(s, sIdx, qCount); !ok {
+					return false, nil
+				}
+				continue
+			}
 
-			// Try matching zero characters first (greedy approach - match as few as possible)
+			// Save state for backtracking with question count limit, then try
+			// matching zero characters first (match as few as possible)
+			if !bt.enterQuestion(pIdx, sIdx, qCount) {
+				return backtrackFailed(&bt, pattern, s, fold, opts)
+			}
 			continue
 		}
 
@@ -348,6 +473,22 @@ func MatchInternalFold[T ~string | ~[]byte](pattern, s T, fold bool) (bool, erro
 				return true, nil // Matched successfully
 			}
 			// Mismatch, fall through to backtrack
+		} else if prop, end, _ := parseProperty(pattern, pIdx); prop != nil {
+			// Property escape; the pattern was validated above
+			var sRune rune
+			var sRuneWidth int
+			if isString {
+				sRune, sRuneWidth = utf8.DecodeRuneInString(sStr[sIdx:])
+			} else {
+				sRune, sRuneWidth = utf8.DecodeRune(sBytes[sIdx:])
+			}
+
+			if prop.matches(sRune) {
+				pIdx = end
+				sIdx += sRuneWidth
+				continue
+			}
+			// Property doesn't match, fall through to backtrack
 		} else if pIdx < pLen && pattern[pIdx] == wildcardEscape {
 			// Escape sequence handling with proper UTF-8 decoding (must be before regular character match!)
 			if pIdx+1 >= pLen {
@@ -375,11 +516,11 @@ func MatchInternalFold[T ~string | ~[]byte](pattern, s T, fold bool) (bool, erro
 			} else {
 				// Check if escaped character matches with proper UTF-8 decoding
 				if sIdx < sLen {
-					var pRune, sRune rune
+					var sRune rune
 					var sRuneWidth int
 
-					// Get the escaped character (next byte after backslash)
-					pRune = rune(pattern[pIdx+1])
+					// Get the escaped character, a whole rune after the backslash
+					pRune, pRuneWidth := decodeRune(pattern, pIdx+1)
 
 					// Decode the input character properly
 					if isString {
@@ -396,7 +537,7 @@ func MatchInternalFold[T ~string | ~[]byte](pattern, s T, fold bool) (bool, erro
 					}
 
 					if matches {
-						pIdx += 2 // Skip backslash and escaped character
+						pIdx += 1 + pRuneWidth // Skip backslash and escaped character
 						sIdx += sRuneWidth
 						// Check for immediate success after escape sequence
 						if pIdx >= pLen && sIdx >= sLen {
@@ -407,7 +548,7 @@ func MatchInternalFold[T ~string | ~[]byte](pattern, s T, fold bool) (bool, erro
 				}
 			}
 			// Escaped character doesn't match, fall through to backtrack
-		} else if pIdx < pLen && pattern[pIdx] == wildcardDot {
+		} else if pIdx < pLen && pattern[pIdx] == wildcardDot && !opts.LiteralDot {
 			// `.` matches any single character except newline with proper UTF-8 decoding
 			if sIdx >= sLen {
 				// No character available, fall through to backtrack
@@ -448,7 +589,13 @@ func MatchInternalFold[T ~string | ~[]byte](pattern, s T, fold bool) (bool, erro
 					sRune, sRuneWidth = utf8.DecodeRune(sBytes[sIdx:])
 				}
 
-				if cc.MatchesWithFold(sRune, fold) {
+				var matched bool
+				if fold && opts.FoldClasses {
+					matched = cc.matchesFolded(sRune, opts.Turkic)
+				} else {
+					matched = cc.MatchesWithFold(sRune, fold)
+				}
+				if matched {
 					pIdx = newPIdx
 					sIdx += sRuneWidth
 					continue
@@ -482,55 +629,23 @@ func MatchInternalFold[T ~string | ~[]byte](pattern, s T, fold bool) (bool, erro
 			}
 		}
 
-		// Case 4: Mismatch or end of pattern. We must backtrack.
-		// First, try ? wildcard backtracking (most recent decisions)
-		if questionIdx != -1 && qTmpIdx < sLen && qMatched < qCount {
-			// Try matching one more character with ? and retry
-			var runeWidth int
-			if isString {
-				_, runeWidth = utf8.DecodeRuneInString(sStr[qTmpIdx:])
-			} else {
-				_, runeWidth = utf8.DecodeRune(sBytes[qTmpIdx:])
-			}
-			qTmpIdx += runeWidth
-			qMatched++
-			pIdx = questionIdx
-			sIdx = qTmpIdx
-			continue
+		// Case 4: Mismatch or end of pattern. We must backtrack, retrying the most
+		// recent `?` or star decision that has any choice left.
+		var ok bool
+		if pIdx, sIdx, ok = bt.next(); !ok {
+			return backtrackFailed(&bt, pattern, s, fold, opts)
 		}
+	}
+}
 
-		// If ? backtracking exhausted, try * wildcard backtracking
-		if starIdx != -1 && sTmpIdx < sLen {
-			// Reset ? state since we're trying a different path
-			questionIdx, qTmpIdx = -1, -1
-			qCount, qMatched = 0, 0
-			pIdx = starIdx
-
-			// Optimize: use index-based search if we have a literal after *
-			if hasStarLiteral {
-				// Find next occurrence of the literal sequence
-				var nextPos int
-				if isString {
-					nextPos = strings.Index(sStr[sTmpIdx+1:], starLiteral)
-				} else {
-					nextPos = bytes.Index(sBytes[sTmpIdx+1:], starLiteralBytes)
-				}
-
-				if nextPos == -1 {
-					// No more occurrences of the literal - match fails
-					return false, nil
-				}
-				sTmpIdx += nextPos + 1
-			} else {
-				// Fall back to incremental advancement
-				sTmpIdx++
-			}
-
-			sIdx = sTmpIdx
-			continue
+// skipRunes advances n runes from s[i], reporting false if s ends first.
+func skipRunes[T ~string | ~[]byte](s T, i, n int) (int, bool) {
+	for ; n > 0; n-- {
+		if i >= len(s) {
+			return i, false
 		}
-
-		// No backtracking options left.
-		return false, nil
+		_, width := decodeRune(s, i)
+		i += width
 	}
+	return i, true
 }
@@ -10,6 +10,8 @@ Neither the name of the copyright holder nor the names of its contributors may b
 package wildcard
 
 import (
+	"errors"
+	"math/rand"
 	"strings"
 	"testing"
 )
@@ -179,6 +181,14 @@ var baseTestCases = []struct {
 	{"test123", "*[0-9]", true},
 	{"testABC", "*[0-9]", false},
 
+	// POSIX named classes
+	{"Hello42", "[[:upper:]][[:lower:]]*[[:digit:]]", true},
+	{"hello42", "[[:upper:]][[:lower:]]*[[:digit:]]", false},
+	{"a b", "a[[:space:]]b", true},
+	{"a_b", "a[[:alnum:]_]b", true},
+	{"ff00", "[[:xdigit:]][[:xdigit:]]*", true},
+	{"[:", "[[:]*", true},
+
 	// Empty matches with character classes
 	{"", "[a-z]*", false}, // [a-z]* requires at least one char from [a-z]
 	{"", "[a-z]", false},
@@ -420,12 +430,113 @@ func TestMatchErrors(t *testing.T) {
 		if err == nil {
 			t.Errorf("Test %d: Expected error for pattern '%s', but got none. %s", i+1, c.pattern, c.desc)
 		}
-		if err != nil && err != ErrBadPattern {
+		if err != nil && !errors.Is(err, ErrBadPattern) {
 			t.Errorf("Test %d: Expected ErrBadPattern, got %v for pattern '%s'", i+1, err, c.pattern)
 		}
 	}
 }
 
+// braceCases contains test cases for brace alternatives `{a,b}`
+var braceCases = []struct {
+	s       string
+	pattern string
+	result  bool
+}{
+	{"photo.jpg", "*.{jpg,png,gif}", true},
+	{"photo.png", "*.{jpg,png,gif}", true},
+	{"photo.bmp", "*.{jpg,png,gif}", false},
+	{"photo.jpgx", "*.{jpg,png,gif}", false},
+	{"ab", "a{b,c}", true},
+	{"ac", "a{b,c}", true},
+	{"a", "a{b,c}", false},
+	{"a", "a{,b}", true},  // Empty alternative
+	{"ab", "a{,b}", true}, // Non-empty alternative
+	{"a", "a{}", true},    // Empty group
+	{"abd", "a{b,bc}d", true},
+	{"abcd", "a{b,bc}d", true}, // The shorter alternative fails first
+	{"abcd", "a{b*,x}d", true}, // Wildcards inside alternatives
+	{"axd", "a{b*,x}d", true},
+	{"axxd", "a{b*,x}d", false},
+	{"ab1", "a{b{1,2},c}", true}, // Nested groups
+	{"ab2", "a{b{1,2},c}", true},
+	{"ac", "a{b{1,2},c}", true},
+	{"ab", "a{b{1,2},c}", false},
+	{"ac1", "a{b{1,2},c}", false},
+	{"xy", "{x,y}{x,y}", true},
+	{"yx", "{x,y}{x,y}", true},
+	{"xyz", "{x,y}{x,y}", false},
+	{"a.b", "{a.b,c}", true},
+	{"a\nb", "{a.b,c}", false},
+	{"a1", "{a[0-9],b}", true},
+	{"a,b", "a,b", true},     // `,` is literal outside a group
+	{"a}", "a}", true},       // `}` is literal outside a group
+	{"{a}", "\\{a\\}", true}, // Escaped braces
+	{"a", "\\{a\\}", false},
+	{"a,b", "{a\\,b}", true}, // Escaped comma
+	{"a", "{a\\,b}", false},
+	{"}", "{\\}}", true}, // Escaped closing brace
+	{"mississippi", "m{*iss,x}*{i,p}", true},
+	{"aaaaaaaaaaaaaaaaaaaaaaaaaaaaab", "{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}*c", false},
+}
+
+// TestMatchBraces validates brace alternatives in both matching engines
+func TestMatchBraces(t *testing.T) {
+	for i, c := range braceCases {
+		result, err := MatchInternal(c.pattern, c.s)
+		if err != nil {
+			t.Errorf("Test %d: Unexpected error: %v; With Pattern: `%s` and String: `%s`", i+1, err, c.pattern, c.s)
+			continue
+		}
+		if c.result != result {
+			t.Errorf("Test %d: Expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+
+		result, err = MatchInternal([]byte(c.pattern), []byte(c.s))
+		if err != nil || c.result != result {
+			t.Errorf("Test %d ([]byte): Expected `%v`, found `%v` (err %v); With Pattern: `%s` and String: `%s`", i+1, c.result, result, err, c.pattern, c.s)
+		}
+
+		result, err = MatchInternalFold(strings.ToUpper(c.pattern), c.s, true)
+		if err != nil || c.result != result {
+			t.Errorf("Test %d (fold): Expected `%v`, found `%v` (err %v); With Pattern: `%s` and String: `%s`", i+1, c.result, result, err, strings.ToUpper(c.pattern), c.s)
+		}
+	}
+
+	// Unclosed groups are malformed
+	for _, pattern := range []string{"{", "a{b,c", "{a,{b}", "*.{jpg"} {
+		if _, err := MatchInternal(pattern, "a"); !errors.Is(err, ErrBadPattern) {
+			t.Errorf("MatchInternal(%q): expected ErrBadPattern, got %v", pattern, err)
+		}
+		if _, err := MatchInternalFold(pattern, "a", true); !errors.Is(err, ErrBadPattern) {
+			t.Errorf("MatchInternalFold(%q): expected ErrBadPattern, got %v", pattern, err)
+		}
+	}
+}
+
+// TestMatchRouting validates that the result for a pattern does not depend on
+// the engine it is routed to, as it did when brace groups or a separator sent
+// patterns with several `?` to the compiled engine only
+func TestMatchRouting(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	inputs := shortInputs(3)
+	for i := 0; i < 300; i++ {
+		pattern := randomPattern(rng, 5)
+		if i == 0 {
+			pattern = "?[a-c]?"
+		}
+		for _, s := range inputs {
+			want := mustMatch(MatchInternal(pattern, s))
+			if got := mustMatch(MatchInternal(pattern+"{x,y}", s+"x")); got != want {
+				t.Errorf("MatchInternal(%q, %q) = %v, but with a brace group %v", pattern, s, want, got)
+			}
+			// The inputs never contain the separator, which then changes nothing
+			if got := mustMatch(MatchInternalWithOptions(pattern, s, Options{Separator: '|'})); got != want {
+				t.Errorf("MatchInternal(%q, %q) = %v, but with a separator %v", pattern, s, want, got)
+			}
+		}
+	}
+}
+
 // TestMatchFromByte validates byte slice matching using baseTestCases converted to bytes
 func TestMatchFromByte(t *testing.T) {
 	for i, c := range baseTestCases {
@@ -508,6 +619,78 @@ func TestMatchEdgeCases(t *testing.T) {
 	}
 }
 
+// TestMatchDifferential validates the backtracking matchers against the compiled
+// engine, the reference for every pattern, on random patterns and short inputs
+func TestMatchDifferential(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	inputs := append(shortInputs(3), "\xff", "a\xffb")
+	for _, pattern := range []string{"?[a-c]?", "??**/*[a-c]", "a?b?", "*??a", "?*?", "?a?*a?*b", "*a??a*?a", "?a?a?a?a?a?a?a?a?a?a"} {
+		checkMatchDifferential(t, pattern, Options{}, inputs)
+	}
+	for i := 0; i < 1000; i++ {
+		checkMatchDifferential(t, randomPattern(rng, 5), randomOpts(rng), inputs)
+	}
+}
+
+// checkMatchDifferential compares MatchInternalWithOptions and
+// MatchInternalFoldWithOptions on pattern with MatchProgram
+func checkMatchDifferential(t *testing.T, pattern string, opts Options, inputs []string) {
+	t.Helper()
+	prog, err := Compile(pattern, opts)
+	if err != nil {
+		t.Fatalf("Compile(%q, %+v): %v", pattern, opts, err)
+	}
+	for _, s := range inputs {
+		if want, got := MatchProgram(prog, s), mustMatch(MatchInternalWithOptions(pattern, s, opts)); got != want {
+			t.Errorf("MatchInternalWithOptions(%q, %q, %+v): expected %v, got %v", pattern, s, opts, want, got)
+		}
+		if got := MatchProgramSubmatch(prog, s) != nil; got != MatchProgram(prog, s) {
+			t.Errorf("MatchProgramSubmatch(%q, %q, %+v): expected a match %v, got %v", pattern, s, opts, !got, got)
+		}
+	}
+	for _, fold := range []bool{false, true} {
+		prog, err := CompileFold(pattern, fold, opts)
+		if err != nil {
+			t.Fatalf("CompileFold(%q, %v, %+v): %v", pattern, fold, opts, err)
+		}
+		for _, s := range inputs {
+			if want, got := MatchProgram(prog, s), mustMatch(MatchInternalFoldWithOptions(pattern, s, fold, opts)); got != want {
+				t.Errorf("MatchInternalFoldWithOptions(%q, %q, %v, %+v): expected %v, got %v", pattern, s, fold, opts, want, got)
+			}
+		}
+	}
+}
+
+// TestMatchBacktrack validates that the backtracking loops retry every run of
+// `?` without allocating, and without trying an input index twice after a star
+func TestMatchBacktrack(t *testing.T) {
+	long := strings.Repeat("a", 10000)
+	for _, pattern := range []string{"?*a?*a?*a?*a?*b", "*a?*a?*a?*a?*a?*b", "?a?a?a?a?a?a?a?b"} {
+		if got := mustMatch(MatchInternal(pattern, long)); got {
+			t.Errorf("MatchInternal(%q, %d a): expected false, got true", pattern, len(long))
+		}
+		if got := mustMatch(MatchInternalFold(pattern, long, true)); got {
+			t.Errorf("MatchInternalFold(%q, %d a): expected false, got true", pattern, len(long))
+		}
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		mustMatch(MatchInternal("user-*-profile-?", "user-42-profile-x"))
+		mustMatch(MatchInternalFold("file?.txt", "FILE1.TXT", true))
+	})
+	if allocs != 0 {
+		t.Errorf("Expected no allocation for patterns with ?, got %v", allocs)
+	}
+}
+
+// mustMatch returns the result of a matcher on a valid pattern
+func mustMatch(matched bool, err error) bool {
+	if err != nil {
+		panic(err)
+	}
+	return matched
+}
+
 // FuzzMatch provides fuzz testing for string matching robustness
 func FuzzMatchM(f *testing.F) {
 	// Add seed corpus with known wildcard patterns
@@ -535,7 +718,7 @@ func FuzzMatchM(f *testing.F) {
 			t.Skipf("Invalid pattern %q: %v", pattern, err)
 		}
 		// Only expect self-matching if the pattern contains no wildcards
-		hasWildcards := strings.ContainsAny(pattern, "*?.[\\")
+		hasWildcards := strings.ContainsAny(pattern, "*?.[{\\")
 		if !hasWildcards && !matched {
 			t.Fatalf("Literal pattern %q does not match itself", pattern)
 		}
@@ -632,7 +815,7 @@ func FuzzMatchFromByte(f *testing.F) {
 			t.Skipf("Invalid pattern %q: %v", s, err)
 		}
 		// Only expect self-matching if the pattern contains no wildcards
-		hasWildcards := strings.ContainsAny(s, "*?.[\\")
+		hasWildcards := strings.ContainsAny(s, "*?.[{\\")
 		if !hasWildcards && !matched {
 			t.Fatalf("Literal byte pattern %q does not match itself", s)
 		}
@@ -680,7 +863,7 @@ func FuzzMatchByRune(f *testing.F) {
 			t.Skipf("Invalid pattern %q: %v", s, err)
 		}
 		// Only expect self-matching if the pattern contains no wildcards
-		hasWildcards := strings.ContainsAny(s, "*?.[\\")
+		hasWildcards := strings.ContainsAny(s, "*?.[{\\")
 		if !hasWildcards && !matched {
 			t.Fatalf("Literal rune pattern %q does not match itself", s)
 		}
@@ -736,6 +919,20 @@ func FuzzMatchNegative(f *testing.F) {
 	f.Add("[abc]", "d")
 	f.Add("[!xyz]", "x")
 
+	// Near misses generated from the patterns themselves. CounterExamples needs
+	// the rune-oriented program, so each seed is checked against MatchInternal.
+	rng := rand.New(rand.NewSource(1))
+	for _, pattern := range []string{"prefix*suffix", "file?.txt", "[a-c]*[0-9]", "{jpg,png}"} {
+		prog, _ := CompileFold(pattern, false, Options{})
+		counters, _ := CounterExamples(prog, 3, rng)
+		for _, input := range counters {
+			if matched, err := MatchInternal(pattern, input); err != nil || matched {
+				f.Fatalf("MatchInternal(%q, %q) = %v, %v: expected a near miss", pattern, input, matched, err)
+			}
+			f.Add(pattern, input)
+		}
+	}
+
 	f.Fuzz(func(t *testing.T, pattern, input string) {
 		matched, err := MatchInternal(pattern, input)
 
@@ -761,7 +958,7 @@ func FuzzMatchNegative(f *testing.F) {
 			}
 
 			// Test literal mismatch
-			if !strings.ContainsAny(pattern, "*?.\\[") { // Pure literal pattern
+			if !strings.ContainsAny(pattern, "*?.\\[{") { // Pure literal pattern
 				if pattern != input && matched {
 					t.Errorf("Literal pattern %q should not match different input %q", pattern, input)
 				}
@@ -939,7 +1136,7 @@ func FuzzMatchFold(f *testing.F) {
 		}
 
 		// Only expect self-matching if the pattern contains no wildcards
-		hasWildcards := strings.ContainsAny(pattern, "*?.[\\")
+		hasWildcards := strings.ContainsAny(pattern, "*?.[{\\")
 		if !hasWildcards && !matched {
 			t.Fatalf("Literal pattern %q does not match itself case-insensitively", pattern)
 		}
@@ -951,7 +1148,7 @@ func FuzzMatchFold(f *testing.F) {
 			lowerPattern := strings.ToLower(pattern)
 
 			// Pattern should match both upper and lower case versions of itself
-			if !strings.ContainsAny(pattern, "\\[") { // Skip complex patterns for this test
+			if !strings.ContainsAny(pattern, "\\[{") { // Skip complex patterns for this test
 				if matched, err := MatchInternalFold(pattern, upperPattern, true); err == nil && !matched {
 					t.Errorf("Pattern %q should match its uppercase version %q", pattern, upperPattern)
 				}
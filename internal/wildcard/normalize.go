@@ -0,0 +1,237 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+// Package wildcard contains optimized wildcard matching implementations.
+// This file provides normalization-insensitive matching, where canonically
+// equivalent text compares equal, so that 'é' written precomposed (NFC) matches
+// 'e' followed by a combining acute accent (NFD). The input is never normalized
+// as a whole: it is split into canonical units, a starter and the characters
+// that may combine with it, and each unit is decomposed only when compared. A
+// unit counts as one character for `.`, `?`, `*` and character classes, so the
+// wildcards consume the same text whatever the normalization form.
+package wildcard
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// Hangul syllables decompose algorithmically into two or three jamo.
+const (
+	hangulBase  = 0xAC00 // First precomposed syllable
+	hangulEnd   = 0xD7A4 // One past the last precomposed syllable
+	leadingBase = 0x1100 // First leading consonant (L)
+	vowelBase   = 0x1161 // First vowel (V)
+	trailBase   = 0x11A7 // One before the first trailing consonant (T)
+	vowelCount  = 21
+	trailCount  = 28
+	leadCount   = 19
+)
+
+// combiningDotAbove follows 'I' in the decomposition of 'İ'.
+const combiningDotAbove = 0x0307
+
+// combiningRange assigns a canonical combining class to a range of runes that
+// never start a canonical unit.
+type combiningRange struct {
+	lo, hi rune
+	ccc    uint8
+}
+
+// combining returns the canonical combining class of r and reports whether r
+// may continue the canonical unit of the preceding character.
+func combining(r rune) (uint8, bool) {
+	if r < 0x0300 {
+		return 0, false
+	}
+	i := sort.Search(len(combiningTable), func(i int) bool { return combiningTable[i].hi >= r })
+	if i < len(combiningTable) && combiningTable[i].lo <= r {
+		return combiningTable[i].ccc, true
+	}
+	return 0, false
+}
+
+// canonicalEnd returns the offset of the first rune at or after s[i] that
+// starts a new canonical unit. With marks set, combining diacritical marks
+// belong to the preceding unit too.
+func canonicalEnd[T ~string | ~[]byte](s T, i int, marks bool) int {
+	for i < len(s) && s[i] >= 0xCC {
+		// Runes below U+0300 are encoded from 0x00 to 0xCB and all start a unit
+		r, width := decodeRune(s, i)
+		if _, ok := combining(r); !ok && !(marks && isCombiningMark(r)) {
+			break
+		}
+		i += width
+	}
+	return i
+}
+
+// appendDecomposition appends the full canonical decomposition of r to buf.
+func appendDecomposition(buf []rune, r rune) []rune {
+	if r < decompositionMin {
+		return append(buf, r)
+	}
+	if hangulBase <= r && r < hangulEnd {
+		s := r - hangulBase
+		buf = append(buf, leadingBase+s/(vowelCount*trailCount), vowelBase+s%(vowelCount*trailCount)/trailCount)
+		if t := s % trailCount; t != 0 {
+			buf = append(buf, trailBase+t)
+		}
+		return buf
+	}
+	if d, ok := decompositionTable[r]; ok {
+		for _, c := range d {
+			buf = append(buf, c)
+		}
+		return buf
+	}
+	return append(buf, r)
+}
+
+// decompose appends the canonical decomposition of the unit s[i:end] to buf,
+// with its non-starters in canonical order.
+func decompose[T ~string | ~[]byte](buf []rune, s T, i, end int, opaque bool) []rune {
+	start := len(buf)
+	for i < end {
+		r, width := decodeChar(s, i, opaque)
+		buf = appendDecomposition(buf, r)
+		i += width
+	}
+
+	// Stable insertion sort of each run of non-starters by combining class
+	d := buf[start:]
+	for i := 1; i < len(d); i++ {
+		c, _ := combining(d[i])
+		if c == 0 {
+			continue
+		}
+		for j := i; j > 0; j-- {
+			if prev, _ := combining(d[j-1]); prev <= c {
+				break
+			}
+			d[j-1], d[j] = d[j], d[j-1]
+		}
+	}
+	return buf
+}
+
+// appendCanonical appends the runes of the unit s[i:end] as compared by the
+// program p: canonically decomposed, without combining diacritical marks when
+// accents are ignored, and with full case folding when it is enabled.
+func appendCanonical[T ~string | ~[]byte](p *Program, buf []rune, s T, i, end int) []rune {
+	var tmp [16]rune
+	d := decompose(tmp[:0], s, i, end, p.opaque())
+	for k := 0; k < len(d); k++ {
+		r := d[k]
+		if p.turkic && !p.ignoreAccents && r == 'I' && k+1 < len(d) && d[k+1] == combiningDotAbove {
+			// 'İ' decomposes to 'I' and a dot above, which Turkic casing keeps
+			// together unless accents, the dot included, are ignored
+			r = capitalDottedI
+			k++
+		}
+		switch {
+		case p.ignoreAccents && isCombiningMark(r):
+		case p.fullFold:
+			buf = appendFullFold(buf, r, p.turkic)
+		default:
+			buf = append(buf, r)
+		}
+	}
+	return buf
+}
+
+// compose returns the primary composite of the runes a and b, if any.
+func compose(a, b rune) (rune, bool) {
+	if leadingBase <= a && a < leadingBase+leadCount && vowelBase <= b && b < vowelBase+vowelCount {
+		return hangulBase + ((a-leadingBase)*vowelCount+b-vowelBase)*trailCount, true
+	}
+	if hangulBase <= a && a < hangulEnd && (a-hangulBase)%trailCount == 0 && trailBase < b && b < trailBase+trailCount {
+		return a + b - trailBase, true
+	}
+	c, ok := compositionTable[[2]rune{a, b}]
+	return c, ok
+}
+
+// composedRune returns the first rune of the canonical composition (NFC) of the
+// unit s[i:end], which is how character classes see the unit.
+func composedRune[T ~string | ~[]byte](s T, i, end int, opaque bool) rune {
+	if end-i == 1 && s[i] < utf8.RuneSelf {
+		return rune(s[i])
+	}
+	var tmp [16]rune
+	d := decompose(tmp[:0], s, i, end, opaque)
+	starter := d[0]
+	if c, _ := combining(starter); c != 0 {
+		return starter
+	}
+
+	// A rune composes with the starter unless a rune left between them is a
+	// starter or has a combining class at least as high
+	blocked, last := false, uint8(0)
+	for _, r := range d[1:] {
+		c, _ := combining(r)
+		if !blocked || last != 0 && last < c {
+			if composite, ok := compose(starter, r); ok {
+				starter = composite
+				continue
+			}
+		}
+		if c == 0 {
+			break
+		}
+		blocked, last = true, c
+	}
+	return starter
+}
+
+// matchCanonical matches lit at s[si:] under canonical equivalence and returns
+// the number of input bytes it consumed. Literal and input are compared as
+// streams of decomposed runes, one unit at a time, and the match must end on a
+// unit boundary of s.
+func (m *matcher[T]) matchCanonical(lit string, si int) (int, bool) {
+	s, p := m.s, m.prog
+	var litBuf, sBuf [16]rune
+	var pending, sPending []rune // Decomposed runes not compared yet
+
+	start := si
+	for li := 0; li < len(lit) || len(pending) > 0; {
+		if len(pending) == 0 {
+			_, width := decodeRune(lit, li)
+			end := canonicalEnd(lit, li+width, p.ignoreAccents)
+			pending = appendCanonical(p, litBuf[:0], lit, li, end)
+			li = end
+			continue
+		}
+		if len(sPending) == 0 {
+			if si >= len(s) {
+				return 0, false
+			}
+			_, width := decodeRune(s, si)
+			end := canonicalEnd(s, si+width, p.ignoreAccents)
+			sPending = appendCanonical(p, sBuf[:0], s, si, end)
+			si = end
+			continue
+		}
+
+		n := min(len(pending), len(sPending))
+		for i := 0; i < n; i++ {
+			if !p.equalRune(pending[i], sPending[i]) {
+				return 0, false
+			}
+		}
+		pending, sPending = pending[n:], sPending[n:]
+	}
+
+	// Input runes left over mean the literal ended inside a unit
+	if len(sPending) > 0 {
+		return 0, false
+	}
+	return si - start, true
+}
@@ -0,0 +1,146 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package wildcard
+
+import (
+	"slices"
+	"testing"
+	"unicode"
+)
+
+// normalizeCases validates normalization-insensitive matching, with "é"
+// spelling 'é' decomposed (NFD) and "é" precomposed (NFC)
+var normalizeCases = []struct {
+	pattern string
+	input   string
+	want    bool
+}{
+	// Precomposed and decomposed letters are equal, in either direction
+	{"résumé*", "résumé.pdf", true},
+	{"résumé*", "résumé.pdf", true},
+	{"Ångström", "Ångström", true}, // ANGSTROM SIGN is a singleton decomposition
+	{"ệ", "ệ", true},              // ệ decomposes to e, dot below, circumflex
+	{"ệ", "ệ", true},              // Marks of different classes in either order
+	{"ệ", "ệ", true},             // Partly composed
+	{"á̀", "à́", false},           // Marks of the same class keep their order
+	{"한글", "한글", true},          // Hangul syllables and jamo
+
+	// Wildcards consume a character with the characters combining with it
+	{"r?sum?.pdf", "résumé.pdf", true},
+	{"caf.", "café", true},
+	{"caf..", "café", false},
+	{"ᄒ.", "한", false}, // A literal never matches part of a syllable
+	{"?", "한", true},
+	{"*́", "café", false},
+	{"*é", "café", true},
+
+	// Classes see the composed form
+	{"caf[é]", "café", true},
+	{"caf[!é]", "café", false},
+	{"caf[a-z]", "café", false},
+	{"caf[a-z]", "café", false},
+
+	// Literals must end on a character boundary
+	{"cafe", "café", false},
+	{"cafe*", "café", false},
+	{"e", "é", false},
+}
+
+// TestNormalize validates the Normalize option in both engines and in compiled programs
+func TestNormalize(t *testing.T) {
+	opts := Options{Normalize: true}
+	for _, c := range normalizeCases {
+		if got, err := MatchInternalWithOptions(c.pattern, c.input, opts); err != nil || got != c.want {
+			t.Errorf("MatchInternalWithOptions(%+q, %+q): expected %v, got %v, %v", c.pattern, c.input, c.want, got, err)
+		}
+		if got, err := MatchInternalFoldWithOptions(c.pattern, c.input, true, opts); err != nil || got != c.want {
+			t.Errorf("MatchInternalFoldWithOptions(%+q, %+q): expected %v, got %v, %v", c.pattern, c.input, c.want, got, err)
+		}
+		if got, err := MatchInternalFoldWithOptions([]byte(c.pattern), []byte(c.input), true, opts); err != nil || got != c.want {
+			t.Errorf("MatchInternalFoldWithOptions([]byte %+q, %+q): expected %v, got %v, %v", c.pattern, c.input, c.want, got, err)
+		}
+	}
+
+	// Without the option the two spellings differ
+	if ok, _ := MatchInternalFoldWithOptions("résumé*", "résumé.pdf", true, Options{}); ok {
+		t.Errorf("Expected NFC and NFD spellings to differ without Normalize")
+	}
+}
+
+// TestNormalizeFold validates that normalization combines with case folding
+func TestNormalizeFold(t *testing.T) {
+	tests := []struct {
+		pattern string
+		input   string
+		opts    Options
+		want    bool
+	}{
+		{"RÉSUMÉ", "résumé", Options{Normalize: true}, true},
+		{"STRASSE", "straße", Options{Normalize: true, FullFold: true}, true},
+		{"ǰ", "J̌", Options{Normalize: true, FullFold: true}, true},
+		{"DİYARBAKIR", "diyarbakır", Options{Normalize: true, Turkic: true}, true},
+		{"cafe", "CAFÉ", Options{Normalize: true, IgnoreAccents: true}, true},
+		{"α", "Ά", Options{Normalize: true, IgnoreAccents: true}, true},                    // Greek accents are marks once decomposed
+		{"İ", "I\u0307", Options{Normalize: true, Turkic: true, IgnoreAccents: true}, true}, // The dot above is an accent
+		{"I\u0307", "İ", Options{Normalize: true, Turkic: true, IgnoreAccents: true}, true},
+		{"İ", "İ", Options{Normalize: true, Turkic: true, IgnoreAccents: true}, true},
+		{"İ", "I", Options{Normalize: true, Turkic: true, IgnoreAccents: true}, true},
+		{"İ", "i", Options{Normalize: true, Turkic: true, IgnoreAccents: true}, false},
+	}
+	for _, tt := range tests {
+		if got, err := MatchInternalFoldWithOptions(tt.pattern, tt.input, true, tt.opts); err != nil || got != tt.want {
+			t.Errorf("MatchInternalFoldWithOptions(%+q, %+q, %+v): expected %v, got %v, %v", tt.pattern, tt.input, tt.opts, tt.want, got, err)
+		}
+	}
+}
+
+// TestNormalizeFind validates that captures and search results never split a character
+func TestNormalizeFind(t *testing.T) {
+	prog, _ := CompileFold("*.", true, Options{Normalize: true})
+	want := []int{0, 6, 0, 3, 3, 6} // "é" is 3 bytes
+	if got := MatchProgramSubmatch(prog, "éé"); !slices.Equal(got, want) {
+		t.Errorf("MatchProgramSubmatch: expected %v, got %v", want, got)
+	}
+
+	prog, _ = CompileFold("é", false, Options{Normalize: true})
+	s := "é é e"
+	if got := FindAllProgram(prog, s, -1); !slices.EqualFunc(got, [][]int{{0, 3}, {4, 6}}, slices.Equal) {
+		t.Errorf("FindAllProgram(%+q): expected [[0 3] [4 6]], got %v", s, got)
+	}
+}
+
+// TestNormalizeSet validates that pattern sets match either spelling
+func TestNormalizeSet(t *testing.T) {
+	p1, _ := CompileFold("résumé*", true, Options{Normalize: true})
+	p2, _ := CompileFold("*.pdf", true, Options{Normalize: true})
+	set := NewSet([]*Program{p1, p2})
+
+	if got := SetMatches(set, "résumé.doc", nil); !slices.Equal(got, []int{0}) {
+		t.Errorf("Expected [0], got %v", got)
+	}
+	if got := SetMatches(set, "résumé.pdf", nil); !slices.Equal(got, []int{0, 1}) {
+		t.Errorf("Expected [0 1], got %v", got)
+	}
+}
+
+// TestNormalizeUnicodeVersion validates that the tables follow the Unicode
+// version of the unicode package, which property escapes and classes use
+func TestNormalizeUnicodeVersion(t *testing.T) {
+	if normalizeUnicodeVersion != unicode.Version {
+		t.Errorf("normalization tables of Unicode %s, but unicode.Version is %s", normalizeUnicodeVersion, unicode.Version)
+	}
+
+	// Tulu-Tigalari, from Unicode 16.0, has canonical compositions
+	want := normalizeUnicodeVersion != "15.0.0"
+	if got, _ := MatchInternalFoldWithOptions("\U00011383", "\U00011382\U000113C9", false, Options{Normalize: true}); got != want {
+		t.Errorf("MatchInternalFoldWithOptions(%+q, %+q): expected %v, got %v", "\U00011383", "\U00011382\U000113C9", want, got)
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+// Package wildcard contains optimized wildcard matching implementations.
+// This file provides Options, the optional matching behaviours understood by the
+// pattern compiler. The zero Options value selects the default semantics of
+// MatchInternal and MatchInternalFold.
+package wildcard
+
+import "unicode/utf8"
+
+// Options configures optional matching behaviour of a compiled Program.
+type Options struct {
+	// Separator enables path-aware matching when non-zero: `*`, `?`, `.` and
+	// character classes never match the separator, while `**` forming a whole
+	// path segment matches zero or more segments. See WithSeparator in the
+	// gowild package for the full rules.
+	Separator rune
+
+	// StrictQuestion makes every `?` match exactly one character, as in shells,
+	// fnmatch and filepath.Match, instead of zero or one.
+	StrictQuestion bool
+
+	// LiteralDot makes `.` match only a literal dot, as in shell globs, instead
+	// of any character except newline.
+	LiteralDot bool
+
+	// FoldClasses makes character classes case-insensitive when matching with
+	// case folding, so that `[a-z]` also matches 'A'. It has no effect on
+	// case-sensitive matching.
+	FoldClasses bool
+
+	// FullFold makes case-insensitive matching use full case folding, where a
+	// character may fold to several, so that "STRASSE" matches "straße" and "fi"
+	// matches 'ﬁ'. It has no effect on case-sensitive matching.
+	FullFold bool
+
+	// Turkic makes case-insensitive matching follow the special casing of
+	// Turkish and Azerbaijani, where 'I' folds to 'ı' and 'İ' to 'i'. It has
+	// no effect on case-sensitive matching.
+	Turkic bool
+
+	// IgnoreAccents makes matching accent-insensitive: precomposed Latin letters
+	// match their base letter and combining diacritical marks are ignored, so
+	// that `cafe*` matches "café au lait". A letter and the marks following it
+	// count as one character for `.`, `?` and character classes.
+	IgnoreAccents bool
+
+	// Graphemes makes `.`, `?`, `*` and character classes consume whole
+	// extended grapheme clusters, as defined by UAX #29, instead of runes, and
+	// requires literals to end on a cluster boundary. A class matches a cluster
+	// when its first rune does.
+	Graphemes bool
+
+	// Normalize makes matching insensitive to Unicode normalization: text that
+	// is canonically equivalent compares equal, so that 'é' written precomposed
+	// (NFC) matches 'e' followed by a combining acute accent (NFD). A character
+	// and the characters combining with it count as one character for `.`,
+	// `?` and character classes, which see their composed form.
+	Normalize bool
+
+	// InvalidUTF8 selects how Unicode-aware matching handles invalid UTF-8 in
+	// the pattern and the input. The zero value is UTF8Replace.
+	InvalidUTF8 UTF8Policy
+
+	// Escape is the escape character of LIKE patterns: 0 selects the default
+	// backslash and a negative value disables escaping, like ESCAPE ''.
+	// Wildcard patterns always use backslash.
+	Escape rune
+}
+
+// unicode reports whether the options require rune-oriented matching even for a
+// byte-oriented program.
+func (o *Options) unicode() bool {
+	return o.Separator >= utf8.RuneSelf || o.IgnoreAccents || o.Graphemes || o.Normalize
+}
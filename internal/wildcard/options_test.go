@@ -0,0 +1,276 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package wildcard
+
+import (
+	"testing"
+)
+
+// separatorCases validates path-aware matching with '/' as the separator
+var separatorCases = []struct {
+	pattern string
+	input   string
+	want    bool
+}{
+	// `*` stays within a segment
+	{"a/*/c", "a/b/c", true},
+	{"a/*/c", "a//c", true},
+	{"a/*/c", "a/b/x/c", false},
+	{"*.go", "main.go", true},
+	{"*.go", "cmd/main.go", false},
+	{"*", "", true},
+	{"*", "a/b", false},
+	{"a*", "a/", false},
+
+	// `?`, `.` and classes never match the separator
+	{"a?b", "a/b", false},
+	{"a?/b", "a/b", true},
+	{"a.b", "a/b", false},
+	{"a[/]b", "a/b", false},
+	{"a[!x]b", "a/b", false},
+	{"a[!x]b", "ayb", true},
+
+	// `**/` matches zero or more whole segments
+	{"a/**/b", "a/b", true},
+	{"a/**/b", "a/x/b", true},
+	{"a/**/b", "a/x/y/b", true},
+	{"a/**/b", "a/xb", false},
+	{"a/**/b", "ab", false},
+	{"a/**/b", "a/x/y/bc", false},
+	{"**/b", "b", true},
+	{"**/b", "x/y/b", true},
+	{"**/b", "xb", false},
+	{"**/*.go", "main.go", true},
+	{"**/*.go", "cmd/tool/main.go", true},
+	{"**/*.go", "cmd/tool/main.c", false},
+	{"a/**/*/c", "a/c", false},
+	{"a/**/*/c", "a/x/c", true},
+	{"a/**/**/b", "a/b", true},
+	{"a/**/**/b", "a/x/y/z/b", true},
+
+	// A trailing `**` matches everything below its parent
+	{"a/**", "a/", true},
+	{"a/**", "a/x/y", true},
+	{"a/**", "a", false},
+	{"a/**", "b/x", false},
+	{"**", "", true},
+	{"**", "x/y/z", true},
+
+	// `**` that is not a whole segment behaves like `*`
+	{"a**b", "axxb", true},
+	{"a**b", "a/b", false},
+	{"a/**b", "a/xb", true},
+	{"a/**b", "a/x/b", false},
+	{"**b", "x/b", false},
+	{"a/**?/b", "a/x/y/b", false},
+
+	// Brace groups at a segment start keep `**` a globstar
+	{"{src,test}/**/*.go", "test/a/b.go", true},
+	{"{src,test}/**/*.go", "src/b.go", true},
+	{"x/{**/a,b}", "x/p/q/a", true},
+	{"x/{**/a,b}", "x/b", true},
+	{"{a,b}*", "a/b", false},
+
+	// Escaped stars are literals
+	{"a/\\*\\*/b", "a/**/b", true},
+	{"a/\\*\\*/b", "a/x/b", false},
+}
+
+// TestSeparator validates path-aware matching for string and []byte input
+func TestSeparator(t *testing.T) {
+	for _, c := range separatorCases {
+		prog, err := Compile(c.pattern, Options{Separator: '/'})
+		if err != nil {
+			t.Fatalf("Compile(%q) failed: %v", c.pattern, err)
+		}
+		if got := MatchProgram(prog, c.input); got != c.want {
+			t.Errorf("Compile(%q) on %q: expected %v, got %v", c.pattern, c.input, c.want, got)
+		}
+		if got := MatchProgram(prog, []byte(c.input)); got != c.want {
+			t.Errorf("Compile(%q) on []byte(%q): expected %v, got %v", c.pattern, c.input, c.want, got)
+		}
+
+		progFold, err := CompileFold(c.pattern, true, Options{Separator: '/'})
+		if err != nil {
+			t.Fatalf("CompileFold(%q) failed: %v", c.pattern, err)
+		}
+		if got := MatchProgram(progFold, c.input); got != c.want {
+			t.Errorf("CompileFold(%q) on %q: expected %v, got %v", c.pattern, c.input, c.want, got)
+		}
+	}
+}
+
+// TestSeparatorUnicode validates a multi-byte separator and folding across segments
+func TestSeparatorUnicode(t *testing.T) {
+	tests := []struct {
+		pattern string
+		input   string
+		fold    bool
+		want    bool
+	}{
+		{"a→*→c", "a→b→c", false, true},
+		{"a→*→c", "a→b→x→c", false, false},
+		{"a→**→c", "a→c", false, true},
+		{"a→**→c", "a→é→x→c", false, true},
+		{"a?c", "a→c", false, false},
+		{"a?c", "aéc", false, true},
+		{"A→**→É*", "a→x→éclair", true, true},
+		{"A→**→É*", "a→x→éclair→b", true, false},
+	}
+
+	for _, tt := range tests {
+		var prog *Program
+		var err error
+		if tt.fold {
+			prog, err = CompileFold(tt.pattern, true, Options{Separator: '→'})
+		} else {
+			prog, err = Compile(tt.pattern, Options{Separator: '→'})
+		}
+		if err != nil {
+			t.Fatalf("Compile(%q) failed: %v", tt.pattern, err)
+		}
+		if got := MatchProgram(prog, tt.input); got != tt.want {
+			t.Errorf("Compile(%q) on %q: expected %v, got %v", tt.pattern, tt.input, tt.want, got)
+		}
+	}
+}
+
+// shellCases validates StrictQuestion and LiteralDot, the shell glob semantics
+var shellCases = []struct {
+	pattern string
+	input   string
+	opts    Options
+	want    bool
+}{
+	// Every `?` consumes exactly one character
+	{"file?.txt", "file.txt", Options{StrictQuestion: true}, false},
+	{"file?.txt", "file1.txt", Options{StrictQuestion: true}, true},
+	{"file?.txt", "file12.txt", Options{StrictQuestion: true}, false},
+	{"??", "ab", Options{StrictQuestion: true}, true},
+	{"??", "a", Options{StrictQuestion: true}, false},
+	{"?", "", Options{StrictQuestion: true}, false},
+	{"a?", "a\n", Options{StrictQuestion: true}, true},
+	{"*?", "", Options{StrictQuestion: true}, false}, // `*?` means at least one character
+	{"*?", "a", Options{StrictQuestion: true}, true},
+	{"*??x", "ax", Options{StrictQuestion: true}, false},
+	{"*??x", "abx", Options{StrictQuestion: true}, true},
+	{"*a?", "xaxa", Options{StrictQuestion: true}, false},
+	{"*a?", "xaxab", Options{StrictQuestion: true}, true},
+	{"?*?", "ab", Options{StrictQuestion: true}, true},
+	{"?.?", "bbb", Options{StrictQuestion: true}, true},
+	{"a\\?", "a?", Options{StrictQuestion: true}, true},
+	{"{a?,b}c", "ac", Options{StrictQuestion: true}, false},
+	{"{a?,b}c", "axc", Options{StrictQuestion: true}, true},
+
+	// `.` only matches a dot
+	{"*.txt", "file.txt", Options{LiteralDot: true}, true},
+	{"*.txt", "file_txt", Options{LiteralDot: true}, false},
+	{"a.b", "a\nb", Options{LiteralDot: true}, false},
+	{"a\\.b", "a.b", Options{LiteralDot: true}, true},
+	{"[.]", ".", Options{LiteralDot: true}, true},
+
+	// Both, as in a shell
+	{"report-??.csv", "report-07.csv", Options{StrictQuestion: true, LiteralDot: true}, true},
+	{"report-??.csv", "report-7.csv", Options{StrictQuestion: true, LiteralDot: true}, false},
+	{"report-??.csv", "report-07_csv", Options{StrictQuestion: true, LiteralDot: true}, false},
+	{"*.{c,h}", "main.h", Options{StrictQuestion: true, LiteralDot: true}, true},
+
+	// Combined with a separator, `?` still never matches it
+	{"a?b", "a/b", Options{StrictQuestion: true, Separator: '/'}, false},
+	{"a/*?/b", "a//b", Options{StrictQuestion: true, Separator: '/'}, false},
+	{"a/*?/b", "a/x/b", Options{StrictQuestion: true, Separator: '/'}, true},
+}
+
+// TestShellSemantics validates StrictQuestion and LiteralDot in the backtracking
+// engines and in compiled programs
+func TestShellSemantics(t *testing.T) {
+	for _, c := range shellCases {
+		if got, err := MatchInternalWithOptions(c.pattern, c.input, c.opts); err != nil || got != c.want {
+			t.Errorf("MatchInternalWithOptions(%q, %q, %+v): expected %v, got %v, %v", c.pattern, c.input, c.opts, c.want, got, err)
+		}
+		if got, err := MatchInternalWithOptions([]byte(c.pattern), []byte(c.input), c.opts); err != nil || got != c.want {
+			t.Errorf("MatchInternalWithOptions([]byte %q, %q, %+v): expected %v, got %v, %v", c.pattern, c.input, c.opts, c.want, got, err)
+		}
+		if got, err := MatchInternalFoldWithOptions(c.pattern, c.input, true, c.opts); err != nil || got != c.want {
+			t.Errorf("MatchInternalFoldWithOptions(%q, %q, %+v): expected %v, got %v, %v", c.pattern, c.input, c.opts, c.want, got, err)
+		}
+
+		prog, err := Compile(c.pattern, c.opts)
+		if err != nil {
+			t.Fatalf("Compile(%q) failed: %v", c.pattern, err)
+		}
+		if got := MatchProgram(prog, c.input); got != c.want {
+			t.Errorf("Compile(%q, %+v) on %q: expected %v, got %v", c.pattern, c.opts, c.input, c.want, got)
+		}
+		progFold, err := CompileFold(c.pattern, true, c.opts)
+		if err != nil {
+			t.Fatalf("CompileFold(%q) failed: %v", c.pattern, err)
+		}
+		if got := MatchProgram(progFold, c.input); got != c.want {
+			t.Errorf("CompileFold(%q, %+v) on %q: expected %v, got %v", c.pattern, c.opts, c.input, c.want, got)
+		}
+	}
+
+	// Strict `?` counts runes in the Unicode engine
+	if ok, _ := MatchInternalFoldWithOptions("caf?", "café", false, Options{StrictQuestion: true}); !ok {
+		t.Errorf("Expected strict `?` to match a multi-byte rune")
+	}
+
+	// The absorbed `?` is part of the star's capture
+	prog, _ := Compile("*?-x", Options{StrictQuestion: true})
+	if got := MatchProgramSubmatch(prog, "ab-x"); got == nil || got[2] != 0 || got[3] != 2 {
+		t.Errorf("Expected the strict star to capture [0 2], got %v", got)
+	}
+}
+
+// TestFoldClasses validates FoldClasses in the backtracking engine and in compiled
+// programs, and that it only applies when folding
+func TestFoldClasses(t *testing.T) {
+	tests := []struct {
+		pattern string
+		input   string
+		fold    bool
+		want    bool
+	}{
+		{"[a-z]*", "Hello", true, true},
+		{"[a-z]*", "Hello", false, false},
+		{"[!a-z]*", "Hello", true, false},
+		{"h[A-Z]llo", "HeLLO", true, true},
+		{"caf[é]", "CAFÉ", true, true},
+		{"*[0-9]", "ABC", true, false},
+	}
+
+	opts := Options{FoldClasses: true}
+	for _, tt := range tests {
+		if got, err := MatchInternalFoldWithOptions(tt.pattern, tt.input, tt.fold, opts); err != nil || got != tt.want {
+			t.Errorf("MatchInternalFoldWithOptions(%q, %q, %v): expected %v, got %v, %v", tt.pattern, tt.input, tt.fold, tt.want, got, err)
+		}
+		if got, err := MatchInternalFoldWithOptions([]byte(tt.pattern), []byte(tt.input), tt.fold, opts); err != nil || got != tt.want {
+			t.Errorf("MatchInternalFoldWithOptions([]byte %q, %q, %v): expected %v, got %v, %v", tt.pattern, tt.input, tt.fold, tt.want, got, err)
+		}
+
+		prog, err := CompileFold(tt.pattern, tt.fold, opts)
+		if err != nil {
+			t.Fatalf("CompileFold(%q) failed: %v", tt.pattern, err)
+		}
+		if got := MatchProgram(prog, tt.input); got != tt.want {
+			t.Errorf("CompileFold(%q, %v) on %q: expected %v, got %v", tt.pattern, tt.fold, tt.input, tt.want, got)
+		}
+		if got := MatchProgram(prog, []byte(tt.input)); got != tt.want {
+			t.Errorf("CompileFold(%q, %v) on []byte %q: expected %v, got %v", tt.pattern, tt.fold, tt.input, tt.want, got)
+		}
+	}
+
+	// Case-sensitive matching ignores the option
+	if ok, _ := MatchInternalWithOptions("[a-z]*", "Hello", opts); ok {
+		t.Errorf("Expected FoldClasses to have no effect on MatchInternalWithOptions")
+	}
+}
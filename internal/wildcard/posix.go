@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+// Package wildcard contains optimized wildcard matching implementations.
+// This file provides the POSIX named classes such as [:alpha:], usable inside
+// bracket expressions as in `[[:alpha:]_]`. The byte-oriented charClass uses
+// the ASCII definitions of the C locale, while charClassFold uses Unicode-aware
+// definitions based on the unicode package.
+package wildcard
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// posixClass is a named character class of a bracket expression.
+type posixClass struct {
+	name    string
+	ascii   func(c byte) bool // Definition for the byte-oriented engine
+	unicode func(r rune) bool // Definition for the Unicode engine
+	re      string            // Unicode definition as RE2 bracket expression members
+}
+
+// posixClasses maps each supported name to its class.
+var posixClasses = map[string]*posixClass{
+	"alnum":  {"alnum", isASCIIAlnum, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }, `\p{L}\p{Nd}`},
+	"alpha":  {"alpha", isASCIIAlpha, unicode.IsLetter, `\p{L}`},
+	"blank":  {"blank", isASCIIBlank, func(r rune) bool { return r == '\t' || unicode.Is(unicode.Zs, r) }, `\t\p{Zs}`},
+	"cntrl":  {"cntrl", func(c byte) bool { return c < ' ' || c == 0x7f }, unicode.IsControl, `\p{Cc}`},
+	"digit":  {"digit", isASCIIDigit, unicode.IsDigit, `\p{Nd}`},
+	"graph":  {"graph", isASCIIGraph, func(r rune) bool { return unicode.IsGraphic(r) && !unicode.IsSpace(r) }, `\p{L}\p{M}\p{N}\p{P}\p{S}`},
+	"lower":  {"lower", func(c byte) bool { return 'a' <= c && c <= 'z' }, unicode.IsLower, `\p{Ll}`},
+	"print":  {"print", func(c byte) bool { return ' ' <= c && c < 0x7f }, unicode.IsGraphic, `\p{L}\p{M}\p{N}\p{P}\p{S}\p{Zs}`},
+	"punct":  {"punct", isASCIIPunct, func(r rune) bool { return unicode.IsPunct(r) || unicode.IsSymbol(r) }, `\p{P}\p{S}`},
+	"space":  {"space", isASCIISpace, unicode.IsSpace, `\t-\r \x{85}\x{A0}\x{1680}\x{2000}-\x{200A}\x{2028}\x{2029}\x{202F}\x{205F}\x{3000}`},
+	"upper":  {"upper", func(c byte) bool { return 'A' <= c && c <= 'Z' }, unicode.IsUpper, `\p{Lu}`},
+	"xdigit": {"xdigit", isASCIIXdigit, func(r rune) bool { return r < utf8.RuneSelf && isASCIIXdigit(byte(r)) }, `0-9A-Fa-f`},
+}
+
+func isASCIIDigit(c byte) bool  { return '0' <= c && c <= '9' }
+func isASCIIAlpha(c byte) bool  { return 'a' <= c|0x20 && c|0x20 <= 'z' }
+func isASCIIAlnum(c byte) bool  { return isASCIIAlpha(c) || isASCIIDigit(c) }
+func isASCIIBlank(c byte) bool  { return c == ' ' || c == '\t' }
+func isASCIISpace(c byte) bool  { return c == ' ' || '\t' <= c && c <= '\r' }
+func isASCIIGraph(c byte) bool  { return ' ' < c && c < 0x7f }
+func isASCIIPunct(c byte) bool  { return isASCIIGraph(c) && !isASCIIAlnum(c) }
+func isASCIIXdigit(c byte) bool { return isASCIIDigit(c) || 'a' <= c|0x20 && c|0x20 <= 'f' }
+
+// parsePosixClass parses a named class such as [:alpha:] at pattern[pi]. It
+// returns nil and pi if there is none, in which case the `[` is an ordinary
+// member of the bracket expression, as it is in `[[]` or `[[:]`. A well-formed
+// name that is not supported is reported as an error rather than silently
+// matching its characters one by one.
+func parsePosixClass[T ~string | ~[]byte](pattern T, pi int) (*posixClass, int, error) {
+	if len(pattern)-pi < 2 || pattern[pi] != wildcardBracket || pattern[pi+1] != ':' {
+		return nil, pi, nil
+	}
+	for end := pi + 2; end+1 < len(pattern); end++ {
+		c := pattern[end]
+		if c == ':' && pattern[end+1] == ']' && end > pi+2 {
+			if pc := posixClasses[string(pattern[pi+2:end])]; pc != nil {
+				return pc, end + 2, nil
+			}
+			return nil, pi, newPatternError(pattern, pi, end+2, ReasonUnknownClass)
+		}
+		if c < 'a' || c > 'z' {
+			break
+		}
+	}
+	return nil, pi, nil
+}
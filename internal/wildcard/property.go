@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+// Package wildcard contains optimized wildcard matching implementations.
+// This file provides Unicode property escapes: `\p{L}` matches a character of a
+// general category or script and `\P{L}` any other character, using the tables
+// of the unicode package. One-letter names may omit the braces, as in `\pL`.
+// Property escapes are usable standalone and inside character classes; patterns
+// containing them are always matched rune by rune.
+package wildcard
+
+import "unicode"
+
+// property is a parsed Unicode property escape.
+type property struct {
+	name    string              // Category or script name, such as "L" or "Han"
+	table   *unicode.RangeTable // Characters having the property
+	negated bool                // `\P`: characters not having the property
+}
+
+// matches reports whether r matches the property escape.
+func (p *property) matches(r rune) bool {
+	return unicode.Is(p.table, r) != p.negated
+}
+
+// parseProperty parses a property escape at pattern[pi]. It returns nil and pi
+// if pattern[pi:] does not start with `\p` or `\P`. Names are looked up among
+// the general categories first, then the scripts; an unknown or unterminated
+// name is reported as an error.
+func parseProperty[T ~string | ~[]byte](pattern T, pi int) (*property, int, error) {
+	if len(pattern)-pi < 2 || pattern[pi] != wildcardEscape || pattern[pi+1] != 'p' && pattern[pi+1] != 'P' {
+		return nil, pi, nil
+	}
+
+	var name string
+	var end int
+	switch {
+	case pi+2 >= len(pattern):
+		return nil, pi, newPatternError(pattern, pi, pi+2, ReasonUnknownProperty)
+	case pattern[pi+2] == wildcardBrace:
+		// Braced name, as in \p{Han}
+		closing := pi + 3
+		for closing < len(pattern) && pattern[closing] != braceClose {
+			closing++
+		}
+		if closing == len(pattern) {
+			return nil, pi, newPatternError(pattern, pi, pi+3, ReasonUnknownProperty)
+		}
+		name, end = string(pattern[pi+3:closing]), closing+1
+	default:
+		// One-letter name, as in \pL
+		name, end = string(pattern[pi+2:pi+3]), pi+3
+	}
+
+	table := unicode.Categories[name]
+	if table == nil {
+		table = unicode.Scripts[name]
+	}
+	if table == nil {
+		return nil, pi, newPatternError(pattern, pi, end, ReasonUnknownProperty)
+	}
+	return &property{name: name, table: table, negated: pattern[pi+1] == 'P'}, end, nil
+}
+
+// hasProperty reports whether pattern contains a property escape.
+func hasProperty[T ~string | ~[]byte](pattern T) bool {
+	for i := 0; i+1 < len(pattern); i++ {
+		if pattern[i] == wildcardEscape {
+			if c := pattern[i+1]; c == 'p' || c == 'P' {
+				return true
+			}
+			i++ // Skip the escaped character
+		}
+	}
+	return false
+}
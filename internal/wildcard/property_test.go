@@ -0,0 +1,126 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+package wildcard
+
+import (
+	"errors"
+	"testing"
+)
+
+// propertyCases validates property escapes, standalone and inside classes
+var propertyCases = []struct {
+	pattern string
+	input   string
+	want    bool
+}{
+	// General categories, with and without braces
+	{"\\p{L}", "é", true},
+	{"\\p{L}", "1", false},
+	{"\\pL", "λ", true},
+	{"\\p{Lu}\\p{Ll}", "Éa", true},
+	{"\\p{Lu}\\p{Ll}", "éa", false},
+	{"\\p{Nd}", "٣", true},
+	{"\\p{N}", "Ⅻ", true},
+
+	// Scripts
+	{"\\p{Han}*", "中文", true},
+	{"\\p{Han}*", "abc", false},
+	{"\\p{Greek}\\p{Greek}", "λx", false},
+	{"\\p{Cyrillic}*", "жук", true},
+
+	// `\P` matches characters without the property
+	{"\\P{Nd}", "x", true},
+	{"\\P{Nd}", "7", false},
+	{"\\PL", "-", true},
+
+	// Inside classes, combined with other members and negation
+	{"user_[\\p{L}\\p{Nd}_][\\p{L}\\p{Nd}_]", "user_名7", true},
+	{"user_[\\p{L}\\p{Nd}_][\\p{L}\\p{Nd}_]", "user_名-", false},
+	{"[^\\p{Han}]", "a", true},
+	{"[^\\p{Han}]", "中", false},
+	{"[\\P{L}]", "1", true},
+	{"[\\p{Lu}-]", "-", true}, // A property is not a range endpoint
+
+	// Escapes of other letters are unchanged
+	{"\\q", "q", true},
+	{"\\\\p", "\\p", true},
+}
+
+// TestProperty validates property escapes in every engine. The byte-oriented
+// engine delegates such patterns to a Unicode-aware program.
+func TestProperty(t *testing.T) {
+	for _, c := range propertyCases {
+		if got, err := MatchInternal(c.pattern, c.input); err != nil || got != c.want {
+			t.Errorf("MatchInternal(%q, %q): expected %v, got %v, %v", c.pattern, c.input, c.want, got, err)
+		}
+		if got, err := MatchInternal([]byte(c.pattern), []byte(c.input)); err != nil || got != c.want {
+			t.Errorf("MatchInternal([]byte %q, %q): expected %v, got %v, %v", c.pattern, c.input, c.want, got, err)
+		}
+		if got, err := MatchInternalFold(c.pattern, c.input, true); err != nil || got != c.want {
+			t.Errorf("MatchInternalFold(%q, %q): expected %v, got %v, %v", c.pattern, c.input, c.want, got, err)
+		}
+
+		prog, err := Compile(c.pattern, Options{})
+		if err != nil {
+			t.Fatalf("Compile(%q) failed: %v", c.pattern, err)
+		}
+		if got := MatchProgram(prog, c.input); got != c.want {
+			t.Errorf("Compile(%q) on %q: expected %v, got %v", c.pattern, c.input, c.want, got)
+		}
+		progFold, err := CompileFold(c.pattern, false, Options{})
+		if err != nil {
+			t.Fatalf("CompileFold(%q) failed: %v", c.pattern, err)
+		}
+		if got := MatchProgram(progFold, c.input); got != c.want {
+			t.Errorf("CompileFold(%q) on %q: expected %v, got %v", c.pattern, c.input, c.want, got)
+		}
+	}
+
+	// A standalone property escape is a capture, like a class
+	prog, _ := Compile("\\p{Han}-*", Options{})
+	if prog.NumCaptures() != 2 {
+		t.Errorf("Expected 2 captures, got %d", prog.NumCaptures())
+	}
+}
+
+// TestPropertyErrors validates that unknown and unterminated names are rejected
+func TestPropertyErrors(t *testing.T) {
+	tests := []struct {
+		pattern string
+		offset  int
+		token   string
+	}{
+		{"\\p{Klingon}", 0, "\\p{Klingon}"},
+		{"a\\p{L", 1, "\\p{"},
+		{"a\\p", 1, "\\p"},
+		{"\\pX", 0, "\\pX"},
+		{"[a\\P{Foo}]", 2, "\\P{Foo}"},
+	}
+
+	for _, tt := range tests {
+		for _, unicode := range []bool{false, true} {
+			err := validate(tt.pattern, unicode)
+			var pe *PatternError
+			if !errors.As(err, &pe) || pe.Offset != tt.offset || pe.Token != tt.token || pe.Reason != ReasonUnknownProperty {
+				t.Errorf("validate(%q, %v): expected %q at offset %d, got %v", tt.pattern, unicode, tt.token, tt.offset, err)
+			}
+		}
+		if _, err := MatchInternal(tt.pattern, "a"); !errors.Is(err, ErrBadPattern) {
+			t.Errorf("MatchInternal(%q): expected ErrBadPattern, got %v", tt.pattern, err)
+		}
+		if _, err := Compile(tt.pattern, Options{}); !errors.Is(err, ErrBadPattern) {
+			t.Errorf("Compile(%q): expected ErrBadPattern, got %v", tt.pattern, err)
+		}
+	}
+
+	if _, err := ToLike("\\p{L}*"); !errors.Is(err, ErrNotExpressible) {
+		t.Errorf("ToLike: expected ErrNotExpressible, got %v", err)
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+// Package wildcard contains optimized wildcard matching implementations.
+// This file provides helpers for building patterns from untrusted text: QuoteMeta
+// escapes the wildcard characters of a literal and Join concatenates patterns
+// without letting one piece change the meaning of the next.
+package wildcard
+
+import "strings"
+
+// QuoteMeta returns s with a backslash before every character of WildcardChars,
+// so that the result is a pattern matching exactly s under any engine and
+// options. Wildcard characters are ASCII, so multi-byte UTF-8 sequences and
+// invalid bytes are copied unchanged.
+func QuoteMeta[T ~string | ~[]byte](s T) T {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		if isWildcardTable[s[i]] {
+			n++
+		}
+	}
+
+	buf := make([]byte, 0, len(s)+n)
+	for i := 0; i < len(s); i++ {
+		if isWildcardTable[s[i]] {
+			buf = append(buf, wildcardEscape)
+		}
+		buf = append(buf, s[i])
+	}
+	return T(buf)
+}
+
+// Join concatenates the patterns in pieces into one pattern matching the
+// concatenation of what each piece matches. Every piece must be a valid pattern,
+// as an open class or brace group would swallow the pieces after it; the
+// *PatternError of the first malformed piece is returned otherwise. A trailing
+// backslash, which matches itself, is escaped so that it does not escape the
+// start of the next piece, and stars meeting at a piece boundary are merged, so
+// that they do not form a `**` globstar.
+func Join(pieces ...string) (string, error) {
+	var b strings.Builder
+	for _, piece := range pieces {
+		if err := Validate(piece); err != nil {
+			return "", err
+		}
+		if err := ValidateFold(piece); err != nil {
+			return "", err
+		}
+		if endsWithStar(b.String()) {
+			piece = strings.TrimLeft(piece, "*")
+		}
+		b.WriteString(piece)
+		if escapesBefore(piece, len(piece))%2 == 1 {
+			b.WriteByte(wildcardEscape)
+		}
+	}
+	return b.String(), nil
+}
+
+// endsWithStar reports whether the valid pattern ends with a `*` wildcard rather
+// than an escaped star.
+func endsWithStar(pattern string) bool {
+	if !strings.HasSuffix(pattern, "*") {
+		return false
+	}
+	// The star is escaped when an odd number of backslashes precede it
+	return escapesBefore(pattern, len(pattern)-1)%2 == 0
+}
+
+// escapesBefore returns the number of consecutive backslashes before pattern[end].
+func escapesBefore(pattern string, end int) int {
+	n := 0
+	for end-n > 0 && pattern[end-n-1] == wildcardEscape {
+		n++
+	}
+	return n
+}
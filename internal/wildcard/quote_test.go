@@ -0,0 +1,152 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package wildcard
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+	"unicode/utf8"
+)
+
+// TestQuoteMeta validates that quoted text matches itself and nothing else
+func TestQuoteMeta(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"", ""},
+		{"abc", "abc"},
+		{"a.b", "a\\.b"},
+		{"*?.[{\\", "\\*\\?\\.\\[\\{\\\\"},
+		{"a,b}]", "a,b}]"},
+		{"café[1]", "café\\[1]"},
+		{"\xff*", "\xff\\*"},
+	}
+	for _, tt := range tests {
+		if got := QuoteMeta(tt.input); got != tt.want {
+			t.Errorf("QuoteMeta(%q): expected %q, got %q", tt.input, tt.want, got)
+		}
+		if got := QuoteMeta([]byte(tt.input)); string(got) != tt.want {
+			t.Errorf("QuoteMeta([]byte %q): expected %q, got %q", tt.input, tt.want, got)
+		}
+	}
+
+	// Quoted random text round-trips through every engine
+	alphabet := []string{"a", "b", "*", "?", ".", "[", "]", "{", "}", ",", "\\", "!", "-", "é", "\n"}
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		var s string
+		for n := rng.Intn(8); n > 0; n-- {
+			s += alphabet[rng.Intn(len(alphabet))]
+		}
+		pattern := QuoteMeta(s)
+		if ok, err := MatchInternal(pattern, s); !ok || err != nil {
+			t.Fatalf("MatchInternal(%q, %q): expected true, got %v, %v", pattern, s, ok, err)
+		}
+		if ok, err := MatchInternalFold(pattern, s, false); !ok || err != nil {
+			t.Fatalf("MatchInternalFold(%q, %q): expected true, got %v, %v", pattern, s, ok, err)
+		}
+		prog, err := Compile(pattern, Options{Separator: '/', StrictQuestion: true, LiteralDot: true})
+		if err != nil || !MatchProgram(prog, s) {
+			t.Fatalf("Compile(%q) on %q: expected a match, got %v", pattern, s, err)
+		}
+		if other := s + "x"; MatchProgram(prog, other) {
+			t.Fatalf("Compile(%q) matched %q", pattern, other)
+		}
+	}
+}
+
+// TestQuoteMetaOptions validates that quoted text matches itself under every
+// combination of options, for text with case variants, accents, combining
+// marks, clusters and invalid UTF-8
+func TestQuoteMetaOptions(t *testing.T) {
+	alphabet := []string{"a", "A", "*", "?", ".", "/", "[", "\\", "é", "e\u0301", "İ", "I\u0307", "ı", "ß", "ﬃ",
+		"\u0301", "한", "\U0001F1EB\U0001F1F7", "\xff", "\xc3", "\xe2\x82"}
+	rng := rand.New(rand.NewSource(1))
+	inputs := []string{"", "a\xffb", "İ"}
+	for len(inputs) < 30 {
+		var s string
+		for n := 1 + rng.Intn(6); n > 0; n-- {
+			s += alphabet[rng.Intn(len(alphabet))]
+		}
+		inputs = append(inputs, s)
+	}
+
+	for bits := 0; bits < 1<<9; bits++ {
+		for _, policy := range []UTF8Policy{UTF8Replace, UTF8Opaque, UTF8Reject} {
+			opts := Options{
+				FullFold:       bits&1 != 0,
+				Turkic:         bits&2 != 0,
+				IgnoreAccents:  bits&4 != 0,
+				Graphemes:      bits&8 != 0,
+				Normalize:      bits&16 != 0,
+				FoldClasses:    bits&32 != 0,
+				StrictQuestion: bits&64 != 0,
+				LiteralDot:     bits&128 != 0,
+				InvalidUTF8:    policy,
+			}
+			if bits&256 != 0 {
+				opts.Separator = '/'
+			}
+			for _, s := range inputs {
+				if policy == UTF8Reject && !utf8.ValidString(s) {
+					continue
+				}
+				pattern := QuoteMeta(s)
+				if ok, err := MatchInternalWithOptions(pattern, s, opts); !ok || err != nil {
+					t.Fatalf("MatchInternalWithOptions(%+q, %+q, %+v): expected true, got %v, %v", pattern, s, opts, ok, err)
+				}
+				for _, fold := range []bool{false, true} {
+					if ok, err := MatchInternalFoldWithOptions(pattern, s, fold, opts); !ok || err != nil {
+						t.Fatalf("MatchInternalFoldWithOptions(%+q, %+q, %v, %+v): expected true, got %v, %v", pattern, s, fold, opts, ok, err)
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestJoin validates pattern concatenation
+func TestJoin(t *testing.T) {
+	tests := []struct {
+		pieces []string
+		want   string
+	}{
+		{nil, ""},
+		{[]string{"tenant-", QuoteMeta("a.b[1]"), "-*"}, "tenant-a\\.b\\[1]-*"},
+		{[]string{"*", "*.go"}, "*.go"},
+		{[]string{"dir/*", "**"}, "dir/*"},
+		{[]string{"a\\*", "*"}, "a\\**"},
+		{[]string{"a\\\\*", "*b"}, "a\\\\*b"},
+		{[]string{"{a,b}", "[0-9]"}, "{a,b}[0-9]"},
+		{[]string{"a\\", "*"}, "a\\\\*"}, // A trailing backslash stays literal
+	}
+	for _, tt := range tests {
+		if got, err := Join(tt.pieces...); err != nil || got != tt.want {
+			t.Errorf("Join(%q): expected %q, got %q (err %v)", tt.pieces, tt.want, got, err)
+		}
+	}
+
+	// A malformed piece is reported rather than merged into the next one
+	for _, pieces := range [][]string{{"{a,", "b}"}, {"[a", "]"}} {
+		if _, err := Join(pieces...); !errors.Is(err, ErrBadPattern) {
+			t.Errorf("Join(%q): expected ErrBadPattern, got %v", pieces, err)
+		}
+	}
+
+	// Merged stars do not form a globstar
+	pattern, _ := Join("x/*", "*/y")
+	prog, _ := Compile(pattern, Options{Separator: '/'})
+	if MatchProgram(prog, "x/a/b/y") {
+		t.Errorf("Join(%q, %q) = %q: expected no match across segments", "x/*", "*/y", pattern)
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package wildcard
+
+import "math/rand"
+
+// randomTokens are the pieces random patterns of the differential tests are built from
+var randomTokens = []string{
+	"a", "b", "A", "é", "/", ",", "}", "*", "?", "??", ".", "\\.", "\\*", "\\a",
+	"[a]", "[ab]", "[!a]", "[a-z]", "[*]", "[.]", "[[:upper:]]", "[[:alpha:]a]", "[é-ê]",
+	"\\p{Lu}", "[\\P{Lu}b]", "{a,b}", "{a*,}", "{?,b/}", "**", "**/",
+}
+
+// randomOptions are the option sets random patterns are compiled with
+var randomOptions = []Options{
+	{}, {StrictQuestion: true}, {LiteralDot: true}, {Separator: '/'},
+	{Separator: '/', StrictQuestion: true}, {FoldClasses: true}, {Turkic: true},
+}
+
+// randomPattern returns a pattern of fewer than n random tokens
+func randomPattern(rng *rand.Rand, n int) string {
+	var pattern string
+	for n = rng.Intn(n); n > 0; n-- {
+		pattern += randomTokens[rng.Intn(len(randomTokens))]
+	}
+	return pattern
+}
+
+// randomOpts returns one of the option sets random patterns are compiled with
+func randomOpts(rng *rand.Rand) Options {
+	return randomOptions[rng.Intn(len(randomOptions))]
+}
+
+// shortInputs returns every string of at most n characters over a small
+// alphabet covering case, separators, dots and multi-byte runes
+func shortInputs(n int) []string {
+	inputs := []string{""}
+	for prev := inputs; n > 0; n-- {
+		var cur []string
+		for _, s := range prev {
+			for _, c := range []string{"a", "A", "b", ".", ",", "/", "\n", "é", "ı"} {
+				cur = append(cur, s+c)
+			}
+		}
+		inputs = append(inputs, cur...)
+		prev = cur
+	}
+	return inputs
+}
@@ -0,0 +1,454 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+// Package wildcard contains optimized wildcard matching implementations.
+// This file provides converters between wildcard patterns and the RE2 syntax of
+// the regexp package, for the patterns both can express. Conversions follow the
+// rune-oriented semantics of MatchInternalFold and CompileFold.
+package wildcard
+
+import (
+	"regexp"
+	"regexp/syntax"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxRegexpRepeat is the largest repetition count accepted by RE2.
+const maxRegexpRepeat = 1000
+
+// ToRegexp converts a wildcard pattern into an RE2 expression matching the same
+// strings as CompileFold(pattern, fold, opts), anchored at both ends. `*` and
+// `?` become repetitions of any character, newline included, `.` a class
+// excluding newline, and folding the (?i) flag, with classes kept
+// case-sensitive unless opts.FoldClasses is set. Separators and `**` globstars
+// are supported. Options that RE2 cannot express, such as full case folding or
+// grapheme clusters, are reported as ErrNotExpressible, as is a class that
+// includes the separator.
+func ToRegexp(pattern string, fold bool, opts Options) (string, error) {
+	if err := ValidateFold(pattern); err != nil {
+		return "", err
+	}
+	switch {
+	case fold && opts.FullFold:
+		return "", notExpressibleOption(pattern, "full case folding", "regexp")
+	case fold && opts.Turkic:
+		return "", notExpressibleOption(pattern, "Turkic casing", "regexp")
+	case opts.IgnoreAccents:
+		return "", notExpressibleOption(pattern, "accent-insensitive matching", "regexp")
+	case opts.Graphemes:
+		return "", notExpressibleOption(pattern, "grapheme clusters", "regexp")
+	case opts.Normalize:
+		return "", notExpressibleOption(pattern, "normalization-insensitive matching", "regexp")
+	case opts.InvalidUTF8 != UTF8Replace:
+		return "", notExpressibleOption(pattern, "invalid UTF-8 policy", "regexp")
+	case fold && unicode.SimpleFold(opts.Separator) != opts.Separator:
+		// The (?i) flag would exclude the case variants of the separator too
+		return "", notExpressibleOption(pattern, "separator with case variants", "regexp")
+	}
+
+	c := regexpConverter{pattern: pattern, fold: fold, opts: opts}
+	if opts.Separator != 0 {
+		c.sep = regexpClassRune(opts.Separator)
+	}
+	if fold {
+		c.b.WriteString("(?i)")
+	}
+	c.b.WriteString(`^(?:`)
+	if err := c.convert(); err != nil {
+		return "", err
+	}
+	c.b.WriteString(`)$`)
+	return c.b.String(), nil
+}
+
+// regexpConverter holds the state of ToRegexp.
+type regexpConverter struct {
+	pattern string
+	fold    bool
+	opts    Options
+	sep     string // The separator as a bracket expression member, "" if none
+	b       strings.Builder
+}
+
+// anyChar returns the expression matching a character consumed by `*` and `?`.
+func (c *regexpConverter) anyChar() string {
+	if c.sep != "" {
+		return "[^" + c.sep + "]"
+	}
+	return "(?s:.)"
+}
+
+// convert writes the expression for the whole pattern, following the parser of
+// compile so that `**` segments and brace groups are recognized the same way.
+func (c *regexpConverter) convert() error {
+	pattern, sep := c.pattern, c.opts.Separator
+
+	// Open brace groups record whether they start a path segment
+	var groups []bool
+	segStart := true
+
+	for pIdx := 0; pIdx < len(pattern); {
+		switch ch := pattern[pIdx]; {
+		case ch == wildcardStar || ch == wildcardQuestion:
+			end, stars := pIdx, 0
+			for end < len(pattern) && (pattern[end] == wildcardStar || pattern[end] == wildcardQuestion) {
+				if pattern[end] == wildcardStar {
+					stars++
+				}
+				end++
+			}
+			if sep != 0 && stars == end-pIdx && stars > 1 && segStart {
+				if end == len(pattern) && len(groups) == 0 {
+					// A trailing globstar matches the rest of the input
+					c.b.WriteString("(?s:.*)")
+					pIdx = end
+					continue
+				}
+				if r, width := utf8.DecodeRuneInString(pattern[end:]); r == sep {
+					// Zero or more whole segments
+					c.b.WriteString("(?:[^" + c.sep + "]*" + regexp.QuoteMeta(string(sep)) + ")*")
+					pIdx = end + width
+					continue
+				}
+			}
+
+			questions := end - pIdx - stars
+			min := 0
+			if c.opts.StrictQuestion {
+				min = questions
+			}
+			if min > maxRegexpRepeat || stars == 0 && questions > maxRegexpRepeat {
+				return notExpressible(pattern, pIdx, end, "regexp")
+			}
+			c.b.WriteString(c.anyChar())
+			switch {
+			case stars > 0 && min == 0:
+				c.b.WriteByte('*')
+			case stars > 0:
+				c.b.WriteString("{" + strconv.Itoa(min) + ",}")
+			case min == questions && min == 1:
+			case min == questions:
+				c.b.WriteString("{" + strconv.Itoa(min) + "}")
+			case questions == 1:
+				c.b.WriteByte('?')
+			default:
+				c.b.WriteString("{" + strconv.Itoa(min) + "," + strconv.Itoa(questions) + "}")
+			}
+			segStart = false
+			pIdx = end
+
+		case ch == wildcardDot && !c.opts.LiteralDot:
+			c.b.WriteString(`[^\n` + c.sep + "]")
+			segStart = false
+			pIdx++
+
+		case ch == wildcardBracket:
+			cc, end, err := parseCharClassFold(pattern, pIdx, false)
+			if err != nil {
+				return err
+			}
+			if err := c.class(cc, pIdx, end); err != nil {
+				return err
+			}
+			segStart = false
+			pIdx = end
+
+		case ch == wildcardBrace:
+			groups = append(groups, segStart)
+			c.b.WriteString("(?:")
+			pIdx++
+
+		case ch == braceComma && len(groups) > 0:
+			c.b.WriteByte('|')
+			segStart = groups[len(groups)-1]
+			pIdx++
+
+		case ch == braceClose && len(groups) > 0:
+			groups = groups[:len(groups)-1]
+			c.b.WriteByte(')')
+			segStart = false
+			pIdx++
+
+		case ch == wildcardEscape:
+			prop, end, err := parseProperty(pattern, pIdx)
+			if err != nil {
+				return err
+			}
+			if prop != nil {
+				if err := c.class(&charClassFold{Props: []*property{prop}}, pIdx, end); err != nil {
+					return err
+				}
+				segStart = false
+				pIdx = end
+				continue
+			}
+			if pIdx+1 < len(pattern) {
+				// The escaped rune is a literal
+				pIdx++
+			}
+			fallthrough
+
+		default:
+			r, width := utf8.DecodeRuneInString(pattern[pIdx:])
+			c.b.WriteString(regexp.QuoteMeta(string(r)))
+			segStart = sep != 0 && r == sep
+			pIdx += width
+		}
+	}
+	return nil
+}
+
+// class writes the bracket expression for the character class cc, parsed from
+// pattern[start:end]. A class never matches the separator, which a negated
+// class excludes explicitly; a class including it cannot be expressed. Neither
+// can a negated property under folding, which RE2 folds before negating.
+func (c *regexpConverter) class(cc *charClassFold, start, end int) error {
+	caseSensitive := c.fold && !c.opts.FoldClasses
+	if c.fold && !caseSensitive {
+		for _, prop := range cc.Props {
+			if prop.negated {
+				return notExpressible(c.pattern, start, end, "regexp")
+			}
+		}
+	}
+	if sep := c.opts.Separator; sep != 0 && !cc.Negated {
+		member := cc.contains(sep)
+		if !caseSensitive && c.fold {
+			member = cc.matchesFolded(sep, false)
+		}
+		if member {
+			return notExpressible(c.pattern, start, end, "regexp")
+		}
+	}
+
+	if caseSensitive {
+		c.b.WriteString("(?-i:")
+	}
+	c.b.WriteByte('[')
+	if cc.Negated {
+		c.b.WriteByte('^')
+		c.b.WriteString(c.sep)
+	}
+	for _, r := range cc.Chars {
+		c.b.WriteString(regexpClassRune(r))
+	}
+	for _, rg := range cc.Ranges {
+		c.b.WriteString(regexpClassRune(rg.Start) + "-" + regexpClassRune(rg.End))
+	}
+	for _, pc := range cc.Classes {
+		c.b.WriteString(pc.re)
+	}
+	for _, prop := range cc.Props {
+		if prop.negated {
+			c.b.WriteString(`\P{` + prop.name + "}")
+		} else {
+			c.b.WriteString(`\p{` + prop.name + "}")
+		}
+	}
+	c.b.WriteByte(']')
+	if caseSensitive {
+		c.b.WriteByte(')')
+	}
+	return nil
+}
+
+// regexpClassRune returns r as a member of an RE2 bracket expression.
+func regexpClassRune(r rune) string {
+	switch {
+	case strings.ContainsRune(`\[]^-`, r):
+		return `\` + string(r)
+	case unicode.IsPrint(r):
+		return string(r)
+	default:
+		return `\x{` + strconv.FormatInt(int64(r), 16) + "}"
+	}
+}
+
+// FromRegexp converts an RE2 expression into a wildcard pattern matching the
+// same strings under CompileFold without folding. The expression is matched as
+// a whole, so an unanchored start or end becomes `*`. Literals, classes,
+// alternations, optional parts and `.` convert directly; case-insensitive
+// literals become classes of their case variants. Any other repetition, except
+// of a character matching newlines as in `(?s:.*)`, and assertions other than
+// the anchors at both ends are reported as ErrNotExpressible, since `*` matches
+// any text, newlines included.
+func FromRegexp(expr string) (string, error) {
+	re, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return "", err
+	}
+	re = re.Simplify()
+
+	subs := []*syntax.Regexp{re}
+	if re.Op == syntax.OpConcat {
+		subs = re.Sub
+	}
+	var b strings.Builder
+	anchoredStart := len(subs) > 0 && subs[0].Op == syntax.OpBeginText
+	if anchoredStart {
+		subs = subs[1:]
+	} else {
+		b.WriteByte(wildcardStar)
+	}
+	anchoredEnd := len(subs) > 0 && subs[len(subs)-1].Op == syntax.OpEndText
+	if anchoredEnd {
+		subs = subs[:len(subs)-1]
+	}
+
+	for _, sub := range subs {
+		if err := fromRegexp(&b, sub, expr); err != nil {
+			return "", err
+		}
+	}
+	if !anchoredEnd && !endsWithStar(b.String()) {
+		b.WriteByte(wildcardStar)
+	}
+	return b.String(), nil
+}
+
+// fromRegexp writes the wildcard pattern for the expression re, parsed from expr.
+func fromRegexp(b *strings.Builder, re *syntax.Regexp, expr string) error {
+	switch re.Op {
+	case syntax.OpEmptyMatch:
+
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			if re.Flags&syntax.FoldCase != 0 && unicode.SimpleFold(r) != r {
+				// The case variants of r, as a case-sensitive class
+				b.WriteByte(wildcardBracket)
+				for f := r; ; {
+					writeClassRune(b, f)
+					if f = unicode.SimpleFold(f); f == r {
+						break
+					}
+				}
+				b.WriteByte(']')
+				continue
+			}
+			writeLiteralRune(b, r)
+		}
+
+	case syntax.OpAnyCharNotNL:
+		b.WriteByte(wildcardDot)
+
+	case syntax.OpAnyChar:
+		writeClass(b, []rune{0, unicode.MaxRune})
+
+	case syntax.OpCharClass:
+		if len(re.Rune) == 0 {
+			return notExpressibleOption(expr, re.String(), "wildcard")
+		}
+		if slices.Equal(re.Rune, []rune{0, '\n' - 1, '\n' + 1, unicode.MaxRune}) {
+			b.WriteByte(wildcardDot)
+			break
+		}
+		writeClass(b, re.Rune)
+
+	case syntax.OpCapture:
+		return fromRegexp(b, re.Sub[0], expr)
+
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if err := fromRegexp(b, sub, expr); err != nil {
+				return err
+			}
+		}
+
+	case syntax.OpAlternate:
+		b.WriteByte(wildcardBrace)
+		for i, sub := range re.Sub {
+			if i > 0 {
+				b.WriteByte(braceComma)
+			}
+			if err := fromRegexp(b, sub, expr); err != nil {
+				return err
+			}
+		}
+		b.WriteByte(braceClose)
+
+	case syntax.OpQuest:
+		if re.Sub[0].Op == syntax.OpAnyChar {
+			b.WriteByte(wildcardQuestion)
+			break
+		}
+		// An optional part is a brace group with an empty alternative
+		b.WriteByte(wildcardBrace)
+		if err := fromRegexp(b, re.Sub[0], expr); err != nil {
+			return err
+		}
+		b.WriteString(",}")
+
+	case syntax.OpStar:
+		if re.Sub[0].Op != syntax.OpAnyChar {
+			return notExpressibleOption(expr, re.String(), "wildcard")
+		}
+		b.WriteByte(wildcardStar)
+
+	case syntax.OpPlus:
+		if re.Sub[0].Op != syntax.OpAnyChar {
+			return notExpressibleOption(expr, re.String(), "wildcard")
+		}
+		writeClass(b, []rune{0, unicode.MaxRune})
+		b.WriteByte(wildcardStar)
+
+	default:
+		// Assertions, and anchors other than at both ends
+		return notExpressibleOption(expr, re.String(), "wildcard")
+	}
+	return nil
+}
+
+// writeLiteralRune writes r as a literal, escaping wildcard characters and the
+// brace group separators.
+func writeLiteralRune(b *strings.Builder, r rune) {
+	if r < utf8.RuneSelf && (isWildcardTable[r] || r == braceComma || r == braceClose) {
+		b.WriteByte(wildcardEscape)
+	}
+	b.WriteRune(r)
+}
+
+// writeClass writes the character class of the sorted rune ranges in ranges,
+// given as pairs of bounds. A class reaching the last rune is written negated,
+// listing the ranges it excludes.
+func writeClass(b *strings.Builder, ranges []rune) {
+	b.WriteByte(wildcardBracket)
+	if n := len(ranges); ranges[n-1] == unicode.MaxRune && (ranges[0] != 0 || n > 2) {
+		b.WriteByte('!')
+		var complement []rune
+		if ranges[0] > 0 {
+			complement = append(complement, 0, ranges[0]-1)
+		}
+		for i := 1; i+1 < n; i += 2 {
+			complement = append(complement, ranges[i]+1, ranges[i+1]-1)
+		}
+		ranges = complement
+	}
+	for i := 0; i < len(ranges); i += 2 {
+		writeClassRune(b, ranges[i])
+		if ranges[i+1] != ranges[i] {
+			b.WriteByte('-')
+			writeClassRune(b, ranges[i+1])
+		}
+	}
+	b.WriteByte(']')
+}
+
+// writeClassRune writes r as a member of a character class, escaping the
+// characters with a special meaning in classes.
+func writeClassRune(b *strings.Builder, r rune) {
+	if r < utf8.RuneSelf && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+		b.WriteByte(wildcardEscape)
+	}
+	b.WriteRune(r)
+}
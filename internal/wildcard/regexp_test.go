@@ -0,0 +1,167 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package wildcard
+
+import (
+	"errors"
+	"math/rand"
+	"regexp"
+	"testing"
+)
+
+// TestToRegexp validates conversions of wildcard patterns to RE2 expressions
+func TestToRegexp(t *testing.T) {
+	tests := []struct {
+		pattern string
+		fold    bool
+		opts    Options
+		want    string
+	}{
+		{"*.txt", false, Options{}, `^(?:(?s:.)*[^\n]txt)$`},
+		{"file?.log", false, Options{StrictQuestion: true, LiteralDot: true}, `^(?:file(?s:.)\.log)$`},
+		{"a??b", false, Options{}, `^(?:a(?s:.){0,2}b)$`},
+		{"*.{jpg,png}", true, Options{LiteralDot: true}, `(?i)^(?:(?s:.)*\.(?:jpg|png))$`},
+		{"[a-c!]x", true, Options{}, `(?i)^(?:(?-i:[!a-c])x)$`},
+		{"[!a-c]", true, Options{FoldClasses: true}, `(?i)^(?:[^a-c])$`},
+		{"[[:digit:]]\\p{Han}", false, Options{}, `^(?:[\p{Nd}][\p{Han}])$`},
+		{"\\*\\", false, Options{}, `^(?:\*\\)$`},
+		{"src/**/*.go", false, Options{Separator: '/', LiteralDot: true}, `^(?:src/(?:[^/]*/)*[^/]*\.go)$`},
+		{"a/**", false, Options{Separator: '/'}, `^(?:a/(?s:.*))$`},
+		{"[!a]", false, Options{Separator: '/'}, `^(?:[^/a])$`},
+	}
+	for _, tt := range tests {
+		got, err := ToRegexp(tt.pattern, tt.fold, tt.opts)
+		if err != nil || got != tt.want {
+			t.Errorf("ToRegexp(%q, %v, %+v): expected %q, got %q (err %v)", tt.pattern, tt.fold, tt.opts, tt.want, got, err)
+		}
+	}
+
+	notExpressible := []struct {
+		pattern string
+		fold    bool
+		opts    Options
+	}{
+		{"a", true, Options{FullFold: true}},
+		{"a", false, Options{Graphemes: true}},
+		{"a", false, Options{InvalidUTF8: UTF8Reject}},
+		{"[/a]", false, Options{Separator: '/'}},
+		{"\\p{P}", false, Options{Separator: '/'}},
+		{"[\\P{Lu}b]", true, Options{FoldClasses: true}},
+	}
+	for _, tt := range notExpressible {
+		if _, err := ToRegexp(tt.pattern, tt.fold, tt.opts); !errors.Is(err, ErrNotExpressible) {
+			t.Errorf("ToRegexp(%q, %+v): expected ErrNotExpressible, got %v", tt.pattern, tt.opts, err)
+		}
+	}
+	if _, err := ToRegexp("abc[", false, Options{}); !errors.Is(err, ErrBadPattern) {
+		t.Errorf("ToRegexp(%q): expected ErrBadPattern, got %v", "abc[", err)
+	}
+}
+
+// TestFromRegexp validates conversions of RE2 expressions to wildcard patterns
+func TestFromRegexp(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{`^abc$`, "abc"},
+		{`abc`, "*abc*"},
+		{`^a.c`, "a.c*"},
+		{`(?s)^.*\.txt$`, "*\\.txt"},
+		{`^a(?s:.)?b$`, "a?b"},
+		{`^(?:jpe?g|png)$`, "{jp{e,}g,png}"},
+		{`^[a-c,]\d$`, "[\\,a-c][0-9]"},
+		{`^[^a-c]$`, "[!a-c]"},
+		{`^(?i)k$`, "[Kk\u212A]"}, // KELVIN SIGN
+		{`^\{\*\}$`, "\\{\\*\\}"},
+		{``, "*"},
+	}
+	for _, tt := range tests {
+		got, err := FromRegexp(tt.expr)
+		if err != nil || got != tt.want {
+			t.Errorf("FromRegexp(%q): expected %q, got %q (err %v)", tt.expr, tt.want, got, err)
+		}
+	}
+
+	for _, expr := range []string{`^a.*$`, `^a+$`, `\bword\b`, `(?m)^a$`, `a|^b`, `[^\x00-\x{10FFFF}]`} {
+		if _, err := FromRegexp(expr); !errors.Is(err, ErrNotExpressible) {
+			t.Errorf("FromRegexp(%q): expected ErrNotExpressible, got %v", expr, err)
+		}
+	}
+}
+
+// regexpInputs are the inputs of the differential tests
+var regexpInputs = []string{
+	"", "a", "b", "ab", "ba", "abc", "aXb", "a\nb", "a/b", "a/b/c", "A", "AB", "é", "É",
+	"a.b", "a*b", "aab", "abab", "1", "a1", "中", "a/", "/b", "ab/c", "ééa",
+}
+
+// TestRegexpDifferential validates converted patterns against the regexp package
+// on random patterns and expressions
+func TestRegexpDifferential(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		pattern, opts := randomPattern(rng, 5), randomOpts(rng)
+		fold := rng.Intn(2) == 0
+
+		expr, err := ToRegexp(pattern, fold, opts)
+		if errors.Is(err, ErrNotExpressible) {
+			continue
+		}
+		if err != nil {
+			t.Fatalf("ToRegexp(%q, %v, %+v): %v", pattern, fold, opts, err)
+		}
+		re := regexp.MustCompile(expr)
+		prog, _ := CompileFold(pattern, fold, opts)
+		for _, s := range regexpInputs {
+			if want, got := MatchProgram(prog, s), re.MatchString(s); got != want {
+				t.Errorf("ToRegexp(%q, %v, %+v) = %q on %q: expected %v, got %v", pattern, fold, opts, expr, s, want, got)
+			}
+		}
+	}
+
+	atoms := []string{"a", "b", "é", ".", "(?s:.)", "[ab]", "[^a]", `\d`, `\*`, "(?i:a)", "/"}
+	suffixes := []string{"", "?", "*", "+", "{2}", "{1,2}"}
+	for i := 0; i < 500; i++ {
+		var expr string
+		if rng.Intn(2) == 0 {
+			expr = "^"
+		}
+		for n := rng.Intn(4); n > 0; n-- {
+			atom := atoms[rng.Intn(len(atoms))]
+			if rng.Intn(4) == 0 {
+				atom = "(?:" + atom + "|" + atoms[rng.Intn(len(atoms))] + ")"
+			}
+			expr += atom + suffixes[rng.Intn(len(suffixes))]
+		}
+		if rng.Intn(2) == 0 {
+			expr += "$"
+		}
+
+		pattern, err := FromRegexp(expr)
+		if errors.Is(err, ErrNotExpressible) {
+			continue
+		}
+		if err != nil {
+			t.Fatalf("FromRegexp(%q): %v", expr, err)
+		}
+		re := regexp.MustCompile(expr)
+		prog, err := CompileFold(pattern, false, Options{})
+		if err != nil {
+			t.Fatalf("FromRegexp(%q) = %q: %v", expr, pattern, err)
+		}
+		for _, s := range regexpInputs {
+			if want, got := re.MatchString(s), MatchProgram(prog, s); got != want {
+				t.Errorf("FromRegexp(%q) = %q on %q: expected %v, got %v", expr, pattern, s, want, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,217 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+// Package wildcard contains optimized wildcard matching implementations.
+// This file provides runeSet, a set of runes stored as sorted ranges, used by
+// the pattern analysis in analysis.go to represent the characters accepted by
+// each transition of a pattern automaton.
+package wildcard
+
+import (
+	"slices"
+	"sort"
+	"sync"
+	"unicode"
+)
+
+// runeSet is a set of runes as sorted, non-overlapping, non-adjacent ranges.
+type runeSet []runeRange
+
+// runeRange is an inclusive range of runes.
+type runeRange struct {
+	lo, hi rune
+}
+
+// validRunes is the set of runes that valid UTF-8 can encode: every rune but the
+// surrogate halves.
+var validRunes = runeSet{{0, 0xD7FF}, {0xE000, unicode.MaxRune}}
+
+// runeSetOf returns the set of the given runes.
+func runeSetOf(runes ...rune) runeSet {
+	var ranges []runeRange
+	for _, r := range runes {
+		ranges = append(ranges, runeRange{r, r})
+	}
+	return normalizeRanges(ranges)
+}
+
+// normalizeRanges sorts ranges and merges the overlapping and adjacent ones.
+func normalizeRanges(ranges []runeRange) runeSet {
+	slices.SortFunc(ranges, func(a, b runeRange) int { return int(a.lo - b.lo) })
+	var s runeSet
+	for _, rg := range ranges {
+		if n := len(s); n > 0 && rg.lo <= s[n-1].hi+1 {
+			s[n-1].hi = max(s[n-1].hi, rg.hi)
+			continue
+		}
+		s = append(s, rg)
+	}
+	return s
+}
+
+// contains reports whether r is in s.
+func (s runeSet) contains(r rune) bool {
+	i := sort.Search(len(s), func(i int) bool { return s[i].hi >= r })
+	return i < len(s) && s[i].lo <= r
+}
+
+// union returns the runes in s or t.
+func (s runeSet) union(t runeSet) runeSet {
+	return normalizeRanges(append(slices.Clone(s), t...))
+}
+
+// complement returns the runes not in s.
+func (s runeSet) complement() runeSet {
+	var c runeSet
+	next := rune(0)
+	for _, rg := range s {
+		if rg.lo > next {
+			c = append(c, runeRange{next, rg.lo - 1})
+		}
+		next = rg.hi + 1
+	}
+	if next <= unicode.MaxRune {
+		c = append(c, runeRange{next, unicode.MaxRune})
+	}
+	return c
+}
+
+// minus returns the runes in s but not in t.
+func (s runeSet) minus(t runeSet) runeSet {
+	return s.complement().union(t).complement()
+}
+
+// intersect returns the runes in both s and t.
+func (s runeSet) intersect(t runeSet) runeSet {
+	return s.complement().union(t.complement()).complement()
+}
+
+// tableSet returns the runes of a unicode.RangeTable.
+func tableSet(table *unicode.RangeTable) runeSet {
+	var ranges []runeRange
+	for _, rg := range table.R16 {
+		ranges = appendStrided(ranges, rune(rg.Lo), rune(rg.Hi), rune(rg.Stride))
+	}
+	for _, rg := range table.R32 {
+		ranges = appendStrided(ranges, rune(rg.Lo), rune(rg.Hi), rune(rg.Stride))
+	}
+	return normalizeRanges(ranges)
+}
+
+// appendStrided appends the runes from lo to hi with the given stride.
+func appendStrided(ranges []runeRange, lo, hi, stride rune) []runeRange {
+	if stride == 1 {
+		return append(ranges, runeRange{lo, hi})
+	}
+	for r := lo; r <= hi; r += stride {
+		ranges = append(ranges, runeRange{r, r})
+	}
+	return ranges
+}
+
+// funcSet returns the runes for which f reports true.
+func funcSet(f func(rune) bool) runeSet {
+	var s runeSet
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if !f(r) {
+			continue
+		}
+		if n := len(s); n > 0 && s[n-1].hi == r-1 {
+			s[n-1].hi = r
+		} else {
+			s = append(s, runeRange{r, r})
+		}
+	}
+	return s
+}
+
+// posixSets caches the Unicode definitions of the POSIX classes as rune sets,
+// which are computed on first use by testing every rune.
+var posixSets sync.Map // Class name to runeSet
+
+// posixSet returns the runes of the Unicode definition of the POSIX class pc.
+func posixSet(pc *posixClass) runeSet {
+	if s, ok := posixSets.Load(pc.name); ok {
+		return s.(runeSet)
+	}
+	s, _ := posixSets.LoadOrStore(pc.name, funcSet(pc.unicode))
+	return s.(runeSet)
+}
+
+// caseRunes lists the runes whose SimpleFold orbit has other members, plus the
+// letters folded differently by the Turkic rules: the only runes for which a
+// case-insensitive class can differ from its case-sensitive members.
+var caseRunes = sync.OnceValue(func() []rune {
+	var runes []rune
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if _, special := turkicFold(r); special || unicode.SimpleFold(r) != r {
+			runes = append(runes, r)
+		}
+	}
+	return runes
+})
+
+// classSet returns the runes matched by the character class cc in the program p.
+func classSet(p *Program, cc *charClassFold) runeSet {
+	var ranges []runeRange
+	for _, r := range cc.Chars {
+		ranges = append(ranges, runeRange{r, r})
+	}
+	for _, rg := range cc.Ranges {
+		ranges = append(ranges, runeRange{rg.Start, rg.End})
+	}
+	s := normalizeRanges(ranges)
+	for _, pc := range cc.Classes {
+		s = s.union(posixSet(pc))
+	}
+	for _, prop := range cc.Props {
+		if prop.negated {
+			s = s.union(tableSet(prop.table).complement())
+		} else {
+			s = s.union(tableSet(prop.table))
+		}
+	}
+	if cc.Negated {
+		s = s.complement()
+	}
+	if !p.foldClasses {
+		return s
+	}
+
+	// Folding only changes the membership of runes with case variants
+	var in, out []rune
+	for _, r := range caseRunes() {
+		if cc.matchesFolded(r, p.turkic) {
+			in = append(in, r)
+		} else {
+			out = append(out, r)
+		}
+	}
+	return s.union(runeSetOf(in...)).minus(runeSetOf(out...))
+}
+
+// literalSet returns the input runes matched by the literal rune r in the
+// program p: r itself, or the runes equal to it under the program's folding.
+func literalSet(p *Program, r rune) runeSet {
+	if !p.fold {
+		return runeSetOf(r)
+	}
+	candidates := []rune{r, 'I', 'i', capitalDottedI, smallDotlessI}
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		candidates = append(candidates, f)
+	}
+	var runes []rune
+	for _, c := range candidates {
+		if p.equalRune(r, c) {
+			runes = append(runes, c)
+		}
+	}
+	return runeSetOf(runes...)
+}
@@ -0,0 +1,382 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+// Package wildcard contains optimized wildcard matching implementations.
+// This file provides Set, which matches one input against many compiled programs
+// in a single pass. Literal prefixes and suffixes of all programs are merged into
+// tries, and required inner literals into an Aho-Corasick automaton, so the input
+// is walked once to find the candidate programs instead of once per program;
+// only the candidates are then executed.
+package wildcard
+
+import (
+	"slices"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Set is a collection of compiled programs indexed for matching in one pass.
+// All programs of a Set must share the same mode (Compile or CompileFold).
+// A Set is immutable once built and safe for concurrent use.
+type Set struct {
+	progs []*Program
+	fold  bool
+
+	prefix trie  // Programs anchored by a literal prefix
+	suffix trie  // Programs anchored by a literal suffix, keys reversed
+	inner  trie  // Programs requiring a literal anywhere, as an Aho-Corasick automaton
+	rest   []int // Programs with no usable literal, always executed
+}
+
+// trie is a byte trie over literal keys. Node 0 is the root.
+type trie struct {
+	nodes []trieNode
+}
+
+type trieNode struct {
+	edges []trieEdge // Sorted by byte
+	ids   []int      // Programs whose key ends at this node
+
+	// Aho-Corasick links, only set on the inner trie: fail is the node of the
+	// longest proper suffix of this node's key present in the trie, and output
+	// the nearest node along the fail chain that has programs (-1 if none).
+	fail   int32
+	output int32
+}
+
+type trieEdge struct {
+	b    byte
+	next int32
+}
+
+// NewSet indexes progs for single-pass matching. The index of each program in
+// progs is the identifier reported by SetMatches.
+func NewSet(progs []*Program) *Set {
+	set := &Set{progs: progs}
+	set.prefix.nodes = []trieNode{{}}
+	set.suffix.nodes = []trieNode{{}}
+	set.inner.nodes = []trieNode{{}}
+	if len(progs) > 0 {
+		set.fold = progs[0].fold
+	}
+
+	for id, p := range progs {
+		// Literals inside brace groups are not required, so they are never used.
+		// Neither are literals under full case folding, which can match input of
+		// another length than the key followed through the tries, or under Turkic
+		// casing or without accents, which the keys do not follow.
+		var prefix, suffix, required string
+		indexed := !p.fullFold && !p.turkic && !p.ignoreAccents && !p.normalize
+		if n := len(p.insts); n > 0 && indexed {
+			if first := p.insts[0]; first.op == opLiteral && first.depth == 0 {
+				prefix = first.lit
+			}
+			if last := p.insts[n-1]; last.op == opLiteral && last.depth == 0 && n > 1 {
+				suffix = last.lit
+			}
+		}
+		if !set.fold && indexed {
+			for _, in := range p.insts {
+				if in.op == opLiteral && in.depth == 0 && len(in.lit) > len(required) {
+					required = in.lit
+				}
+			}
+		}
+
+		// Index each program under its longest literal, preferring anchored ones
+		switch {
+		case prefix != "" && len(prefix) >= len(suffix) && len(prefix) >= len(required):
+			set.prefix.insert(set.key(prefix, false), id)
+		case suffix != "" && len(suffix) >= len(required):
+			set.suffix.insert(set.key(suffix, true), id)
+		case required != "":
+			set.inner.insert([]byte(required), id)
+		default:
+			set.rest = append(set.rest, id)
+		}
+	}
+	set.inner.link()
+
+	return set
+}
+
+// Len returns the number of programs in the set.
+func (set *Set) Len() int {
+	return len(set.progs)
+}
+
+// SetMatches appends to dst the indexes of all programs in set that match s,
+// in ascending order, and returns the extended slice.
+func SetMatches[T ~string | ~[]byte](set *Set, s T, dst []int) []int {
+	start := len(dst)
+	check := func(ids []int) bool {
+		for _, id := range ids {
+			if MatchProgram(set.progs[id], s) {
+				dst = append(dst, id)
+			}
+		}
+		return false
+	}
+
+	str, b, isString := setInput(s)
+	set.candidates(str, b, isString, check)
+	slices.Sort(dst[start:])
+	return dst
+}
+
+// SetMatchAny reports whether at least one program in set matches s.
+// It stops at the first matching program.
+func SetMatchAny[T ~string | ~[]byte](set *Set, s T) bool {
+	found := false
+	str, b, isString := setInput(s)
+	set.candidates(str, b, isString, func(ids []int) bool {
+		for _, id := range ids {
+			if MatchProgram(set.progs[id], s) {
+				found = true
+				return true
+			}
+		}
+		return false
+	})
+	return found
+}
+
+// setInput recovers the concrete input once, so the walks over the tries do not
+// need to be generic. Named string and byte slice types are converted to string.
+func setInput[T ~string | ~[]byte](s T) (string, []byte, bool) {
+	switch v := any(s).(type) {
+	case string:
+		return v, nil, true
+	case []byte:
+		return "", v, false
+	default:
+		return string(s), nil, true
+	}
+}
+
+// candidates calls visit with every group of programs that may match the input,
+// i.e. programs whose literal anchor is present. The input is str if isString is
+// set, b otherwise. It stops as soon as visit returns true.
+func (set *Set) candidates(str string, b []byte, isString bool, visit func(ids []int) bool) {
+	if visit(set.rest) {
+		return
+	}
+
+	if set.search(str, b, isString, visit) {
+		return
+	}
+
+	if set.walk(&set.prefix, str, b, isString, false, visit) {
+		return
+	}
+	set.walk(&set.suffix, str, b, isString, true, visit)
+}
+
+// walk follows the input through t, from the start or (reverse) from the end,
+// and visits the programs of every node reached.
+func (set *Set) walk(t *trie, str string, b []byte, isString, reverse bool, visit func(ids []int) bool) bool {
+	n := len(b)
+	if isString {
+		n = len(str)
+	}
+	at := func(i int) byte {
+		if isString {
+			return str[i]
+		}
+		return b[i]
+	}
+
+	node := 0
+	step := func(c byte) bool {
+		node = t.next(node, c)
+		return node >= 0 && len(t.nodes[node].ids) > 0 && visit(t.nodes[node].ids)
+	}
+
+	if !set.fold {
+		for k := 0; k < n && node >= 0; k++ {
+			i := k
+			if reverse {
+				i = n - 1 - k
+			}
+			if step(at(i)) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Case-insensitive keys are made of canonically folded runes
+	var buf [utf8.UTFMax]byte
+	for k := 0; k < n && node >= 0; {
+		var r rune
+		var width int
+		switch {
+		case reverse && isString:
+			r, width = utf8.DecodeLastRuneInString(str[:n-k])
+		case reverse:
+			r, width = utf8.DecodeLastRune(b[:n-k])
+		case isString:
+			r, width = utf8.DecodeRuneInString(str[k:])
+		default:
+			r, width = utf8.DecodeRune(b[k:])
+		}
+		k += width
+
+		enc := buf[:utf8.EncodeRune(buf[:], canonicalFold(r))]
+		if reverse {
+			slices.Reverse(enc)
+		}
+		for _, c := range enc {
+			if step(c) {
+				return true
+			}
+			if node < 0 {
+				break
+			}
+		}
+	}
+	return false
+}
+
+// search runs the input through the inner Aho-Corasick automaton and visits the
+// programs of every required literal found, each at most once.
+func (set *Set) search(str string, b []byte, isString bool, visit func(ids []int) bool) bool {
+	t := &set.inner
+	if len(t.nodes) == 1 {
+		return false
+	}
+
+	n := len(b)
+	if isString {
+		n = len(str)
+	}
+
+	var seen []uint64 // Nodes already visited, as a bitset
+	node := 0
+	for i := 0; i < n; i++ {
+		var ch byte
+		if isString {
+			ch = str[i]
+		} else {
+			ch = b[i]
+		}
+		node = t.step(node, ch)
+
+		for out := node; out > 0; out = int(t.nodes[out].output) {
+			if len(t.nodes[out].ids) == 0 {
+				continue
+			}
+			if seen == nil {
+				seen = make([]uint64, (len(t.nodes)+63)/64)
+			}
+			if seen[out/64]&(1<<(out%64)) != 0 {
+				break
+			}
+			seen[out/64] |= 1 << (out % 64)
+			if visit(t.nodes[out].ids) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// key returns the trie key for a literal anchor: canonically folded in
+// case-insensitive sets, and byte-reversed for suffix anchors.
+func (set *Set) key(lit string, reverse bool) []byte {
+	var key []byte
+	if set.fold {
+		for _, r := range lit {
+			key = utf8.AppendRune(key, canonicalFold(r))
+		}
+	} else {
+		key = []byte(lit)
+	}
+	if reverse {
+		slices.Reverse(key)
+	}
+	return key
+}
+
+// insert adds id under key.
+func (t *trie) insert(key []byte, id int) {
+	node := 0
+	for _, c := range key {
+		next := t.next(node, c)
+		if next < 0 {
+			next = len(t.nodes)
+			t.nodes = append(t.nodes, trieNode{})
+			edges := t.nodes[node].edges
+			i, _ := slices.BinarySearchFunc(edges, c, func(e trieEdge, c byte) int { return int(e.b) - int(c) })
+			t.nodes[node].edges = slices.Insert(edges, i, trieEdge{b: c, next: int32(next)})
+		}
+		node = next
+	}
+	t.nodes[node].ids = append(t.nodes[node].ids, id)
+}
+
+// link computes the Aho-Corasick fail and output links with a breadth-first walk.
+func (t *trie) link() {
+	t.nodes[0].output = -1
+	queue := []int{0}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, e := range t.nodes[node].edges {
+			child := int(e.next)
+			fail := 0
+			if node != 0 {
+				fail = t.step(int(t.nodes[node].fail), e.b)
+			}
+			t.nodes[child].fail = int32(fail)
+			if len(t.nodes[fail].ids) > 0 {
+				t.nodes[child].output = int32(fail)
+			} else {
+				t.nodes[child].output = t.nodes[fail].output
+			}
+			queue = append(queue, child)
+		}
+	}
+}
+
+// step follows c from node in a linked trie, falling back along fail links.
+func (t *trie) step(node int, c byte) int {
+	for {
+		if next := t.next(node, c); next >= 0 {
+			return next
+		}
+		if node == 0 {
+			return 0
+		}
+		node = int(t.nodes[node].fail)
+	}
+}
+
+// next returns the child of node along c, or -1 if there is none.
+func (t *trie) next(node int, c byte) int {
+	edges := t.nodes[node].edges
+	i, ok := slices.BinarySearchFunc(edges, c, func(e trieEdge, c byte) int { return int(e.b) - int(c) })
+	if !ok {
+		return -1
+	}
+	return int(edges[i].next)
+}
+
+// canonicalFold maps r to the smallest rune of its simple case folding orbit,
+// so that runes equal under equalFoldRune share the same representative.
+func canonicalFold(r rune) rune {
+	canonical := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < canonical {
+			canonical = f
+		}
+	}
+	return canonical
+}
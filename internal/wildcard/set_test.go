@@ -0,0 +1,158 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+package wildcard
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// setReference returns the indexes of the programs matching s, one program at a time
+func setReference(progs []*Program, s string) []int {
+	var ids []int
+	for i, p := range progs {
+		if MatchProgram(p, s) {
+			ids = append(ids, i)
+		}
+	}
+	return ids
+}
+
+// TestSetMatches validates single-pass set matching against matching each program on its own
+func TestSetMatches(t *testing.T) {
+	extra := []string{
+		"foo*", "*bar", "foo*bar", "*oo*", "f?o", "[a-z]oo", "prefix*", "*suffix", "*.txt",
+		// Overlapping inner literals exercise the Aho-Corasick fail and output links
+		"*she*", "*he*", "*hers*", "*his*", "[a-z]*he?[a-z]",
+		// Literals inside brace groups are optional and must not be used as anchors
+		"*.{txt,bar}", "{foo,x}*", "{prefix,pre}-*", "*{she,ar}*",
+	}
+	inputs := []string{"", "foobar", "foo", "fo", "xbar", "hoo", "prefix-suffix", "a.txt", "ushers", "his", "shhe", "pre-x"}
+
+	var patterns []string
+	for _, c := range baseTestCases {
+		patterns = append(patterns, c.pattern)
+		inputs = append(inputs, c.s)
+	}
+	patterns = append(patterns, extra...)
+
+	for _, fold := range []bool{false, true} {
+		progs := make([]*Program, len(patterns))
+		for i, p := range patterns {
+			var err error
+			if fold {
+				progs[i], err = CompileFold(p, true, Options{})
+			} else {
+				progs[i], err = Compile(p, Options{})
+			}
+			if err != nil {
+				t.Fatalf("Compile(%q) failed: %v", p, err)
+			}
+		}
+		set := NewSet(progs)
+
+		if set.Len() != len(patterns) {
+			t.Errorf("Expected Len %d, got %d", len(patterns), set.Len())
+		}
+
+		for _, s := range inputs {
+			want := setReference(progs, s)
+			if got := SetMatches(set, s, nil); !slices.Equal(got, want) {
+				t.Errorf("fold=%v SetMatches(%q): expected %v, got %v", fold, s, want, got)
+			}
+			if got := SetMatches(set, []byte(s), nil); !slices.Equal(got, want) {
+				t.Errorf("fold=%v SetMatches([]byte %q): expected %v, got %v", fold, s, want, got)
+			}
+			if got := SetMatchAny(set, s); got != (len(want) > 0) {
+				t.Errorf("fold=%v SetMatchAny(%q): expected %v, got %v", fold, s, len(want) > 0, got)
+			}
+		}
+	}
+}
+
+// TestSetFold validates that literal anchors are matched case-insensitively in fold sets
+func TestSetFold(t *testing.T) {
+	patterns := []string{"HELLO*", "*WORLD", "*Café*", "straße"}
+	progs := make([]*Program, len(patterns))
+	for i, p := range patterns {
+		progs[i], _ = CompileFold(p, true, Options{})
+	}
+	set := NewSet(progs)
+
+	tests := []struct {
+		s    string
+		want []int
+	}{
+		{"hello world", []int{0, 1}},
+		{"say HELLO", nil},
+		{"un CAFÉ noir", []int{2}},
+		{"STRASSE", nil},
+		{"STRAßE", []int{3}},
+	}
+	for _, tt := range tests {
+		if got := SetMatches(set, tt.s, nil); !slices.Equal(got, tt.want) {
+			t.Errorf("SetMatches(%q): expected %v, got %v", tt.s, tt.want, got)
+		}
+	}
+}
+
+// TestSetAppend validates that SetMatches appends to the destination slice
+func TestSetAppend(t *testing.T) {
+	p1, _ := Compile("a*", Options{})
+	p2, _ := Compile("*b", Options{})
+	set := NewSet([]*Program{p1, p2})
+
+	dst := []int{42}
+	if got := SetMatches(set, "ab", dst); !slices.Equal(got, []int{42, 0, 1}) {
+		t.Errorf("Expected [42 0 1], got %v", got)
+	}
+}
+
+// TestSetMatchInternal validates sets against matching each pattern with
+// MatchInternal and MatchInternalFold, as PatternSet must agree with
+// MatchMultiple and MatchFold
+func TestSetMatchInternal(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	inputs := shortInputs(3)
+	for i := 0; i < 100; i++ {
+		patterns := []string{"?[a-c]?"}
+		for n := rng.Intn(6); n > 0; n-- {
+			patterns = append(patterns, randomPattern(rng, 5))
+		}
+		for _, fold := range []bool{false, true} {
+			progs := make([]*Program, len(patterns))
+			for j, p := range patterns {
+				if fold {
+					progs[j], _ = CompileFold(p, true, Options{})
+				} else {
+					progs[j], _ = Compile(p, Options{})
+				}
+			}
+			set := NewSet(progs)
+
+			for _, s := range inputs {
+				var want []int
+				for j, p := range patterns {
+					matched, _ := MatchInternalFold(p, s, true)
+					if !fold {
+						matched, _ = MatchInternal(p, s)
+					}
+					if matched {
+						want = append(want, j)
+					}
+				}
+				if got := SetMatches(set, s, nil); !slices.Equal(got, want) {
+					t.Errorf("SetMatches(%q, %v, %q): expected %v, got %v", patterns, fold, s, want, got)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,311 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+// Package wildcard contains optimized wildcard matching implementations.
+// This file provides Simplify, which rewrites a pattern into a canonical
+// equivalent, so that patterns differing only in spelling can be deduplicated.
+package wildcard
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// Simplify returns the canonical form of pattern for Compile with opts: an
+// equivalent pattern in which
+//
+//   - runs of `*` and `?` are reduced to what they match, `*` absorbing the `?`
+//     of its run, or `?*` under strict semantics, and globstars to `**`
+//   - character classes have their ranges merged and sorted, followed by the
+//     named classes and property escapes in order, and a class of a single
+//     character becomes that literal
+//   - literals are escaped only where the character would otherwise be special
+//
+// Brace groups keep their alternatives in order. A malformed pattern is
+// reported as a *PatternError.
+func Simplify(pattern string, opts Options) (string, error) {
+	prog, err := Compile(pattern, opts)
+	if err != nil {
+		return "", err
+	}
+	s := simplifier{pattern: pattern, opts: opts, unicode: prog.unicode}
+	return s.simplify(), nil
+}
+
+// simplifier holds the state of Simplify.
+type simplifier struct {
+	pattern string
+	opts    Options
+	unicode bool // Classes and escapes are parsed by runes, as in Unicode programs
+	b       strings.Builder
+	groups  []bool // Open brace groups, recording whether they start a segment
+}
+
+// simplify returns the canonical form of the pattern, which is known to be valid.
+func (s *simplifier) simplify() string {
+	pattern, sep := s.pattern, s.opts.Separator
+
+	// The literal text since the last wildcard, to tell where segments start
+	var lit []byte
+	segStart := true
+	literal := func(text string) {
+		lit = append(lit, text...)
+		segStart = sep != 0 && bytes.HasSuffix(lit, []byte(string(sep)))
+		s.writeLiteral(text)
+	}
+
+	for pIdx := 0; pIdx < len(pattern); {
+		c := pattern[pIdx]
+		if !s.special(c) {
+			literal(pattern[pIdx : pIdx+1])
+			pIdx++
+			continue
+		}
+		if c != wildcardBracket && c != wildcardEscape {
+			// Wildcards and braces end the literal text
+			lit = lit[:0]
+		}
+
+		switch {
+		case c == wildcardStar || c == wildcardQuestion:
+			end, stars := pIdx, 0
+			for end < len(pattern) && (pattern[end] == wildcardStar || pattern[end] == wildcardQuestion) {
+				if pattern[end] == wildcardStar {
+					stars++
+				}
+				end++
+			}
+			if sep != 0 && stars == end-pIdx && stars > 1 && segStart {
+				if end == len(pattern) && len(s.groups) == 0 {
+					s.b.WriteString("**")
+					pIdx = end
+					continue
+				}
+				if r, width := utf8.DecodeRuneInString(pattern[end:]); r == sep {
+					// The globstar absorbs its separator, so a segment starts after it
+					s.b.WriteString("**")
+					s.b.WriteString(pattern[end : end+width])
+					pIdx = end + width
+					continue
+				}
+			}
+
+			questions := end - pIdx - stars
+			switch {
+			case stars == 0:
+				s.b.WriteString(strings.Repeat("?", questions))
+			case s.opts.StrictQuestion:
+				s.b.WriteString(strings.Repeat("?", questions) + "*")
+			default:
+				s.b.WriteByte(wildcardStar)
+			}
+			segStart = false
+			pIdx = end
+
+		case c == wildcardDot:
+			s.b.WriteByte(wildcardDot)
+			segStart = false
+			pIdx++
+
+		case c == wildcardBracket:
+			text, ok, end := s.class(pIdx)
+			if ok {
+				literal(text)
+			} else {
+				lit = lit[:0]
+				s.b.WriteString(text)
+				segStart = false
+			}
+			pIdx = end
+
+		case c == wildcardBrace:
+			s.groups = append(s.groups, segStart)
+			s.b.WriteByte(wildcardBrace)
+			pIdx++
+
+		case c == braceComma:
+			s.b.WriteByte(braceComma)
+			segStart = s.groups[len(s.groups)-1]
+			pIdx++
+
+		case c == braceClose:
+			s.groups = s.groups[:len(s.groups)-1]
+			s.b.WriteByte(braceClose)
+			segStart = false
+			pIdx++
+
+		case c == wildcardEscape:
+			if prop, end, _ := parseProperty(pattern, pIdx); prop != nil {
+				lit = lit[:0]
+				s.writeProperty(prop)
+				segStart = false
+				pIdx = end
+				continue
+			}
+			if pIdx+1 == len(pattern) {
+				// A trailing backslash matches a literal backslash
+				literal(pattern[pIdx:])
+				pIdx++
+				continue
+			}
+			width := 1
+			if s.unicode {
+				_, width = utf8.DecodeRuneInString(pattern[pIdx+1:])
+			}
+			literal(pattern[pIdx+1 : pIdx+1+width])
+			pIdx += 1 + width
+		}
+	}
+	return s.b.String()
+}
+
+// special reports whether c has a meaning of its own at the current position
+// of the pattern, outside character classes.
+func (s *simplifier) special(c byte) bool {
+	switch c {
+	case wildcardStar, wildcardQuestion, wildcardBracket, wildcardBrace, wildcardEscape:
+		return true
+	case wildcardDot:
+		return !s.opts.LiteralDot
+	case braceComma, braceClose:
+		return len(s.groups) > 0
+	}
+	return false
+}
+
+// writeLiteral writes literal text, escaping the characters that are special.
+func (s *simplifier) writeLiteral(text string) {
+	for i := 0; i < len(text); i++ {
+		if s.special(text[i]) {
+			s.b.WriteByte(wildcardEscape)
+		}
+		s.b.WriteByte(text[i])
+	}
+}
+
+// writeProperty writes a property escape in its braced form.
+func (s *simplifier) writeProperty(prop *property) {
+	if prop.negated {
+		s.b.WriteString(`\P{`)
+	} else {
+		s.b.WriteString(`\p{`)
+	}
+	s.b.WriteString(prop.name)
+	s.b.WriteByte(braceClose)
+}
+
+// class parses the character class at pIdx and returns its canonical form and
+// the end of the class. If the class stands for a single character, it returns
+// that character as literal text with ok set instead.
+func (s *simplifier) class(pIdx int) (text string, ok bool, end int) {
+	var (
+		negated bool
+		set     runeSet
+		classes []*posixClass
+		props   []*property
+	)
+	if s.unicode {
+		cc, e, _ := parseCharClassFold(s.pattern, pIdx, false)
+		end = e
+		if !utf8.ValidString(s.pattern[pIdx:end]) {
+			// Invalid bytes may stand for themselves under some policies
+			return s.pattern[pIdx:end], false, end
+		}
+		negated, classes, props = cc.Negated, cc.Classes, cc.Props
+		for _, r := range cc.Chars {
+			set = append(set, runeRange{r, r})
+		}
+		for _, rg := range cc.Ranges {
+			set = append(set, runeRange{rg.Start, rg.End})
+		}
+	} else {
+		cc, e, _ := NewCharClass(s.pattern, pIdx)
+		end = e
+		negated, classes = cc.Negated, cc.Classes
+		for _, c := range cc.Chars {
+			set = append(set, runeRange{rune(c), rune(c)})
+		}
+		for _, rg := range cc.Ranges {
+			set = append(set, runeRange{rune(rg.Start), rune(rg.End)})
+		}
+		if slices.ContainsFunc(set, func(rg runeRange) bool { return rg.hi >= utf8.RuneSelf }) {
+			// Bytes above ASCII match on their own, not as the rune they spell
+			return s.pattern[pIdx:end], false, end
+		}
+	}
+	set = normalizeRanges(set)
+
+	// A class of a single character other than the separator is that literal
+	if len(set) == 1 && set[0].lo == set[0].hi && !negated && len(classes) == 0 && len(props) == 0 &&
+		set[0].lo != s.opts.Separator {
+		return string(set[0].lo), true, end
+	}
+
+	var b strings.Builder
+	b.WriteByte(wildcardBracket)
+	if negated {
+		b.WriteByte('!')
+	}
+	first := true
+	member := func(r rune) {
+		switch {
+		case r == wildcardEscape || r == ']' || r == '-':
+			b.WriteByte(wildcardEscape)
+		case (r == '^' || r == '!') && first && !negated:
+			// Would negate the class
+			b.WriteByte(wildcardEscape)
+		}
+		b.WriteRune(r)
+		first = false
+	}
+	for _, rg := range set {
+		member(rg.lo)
+		switch {
+		case rg.hi == rg.lo+1:
+			member(rg.hi)
+		case rg.hi > rg.lo:
+			b.WriteByte('-')
+			member(rg.hi)
+		}
+	}
+
+	// Named classes and properties follow, each once, in a fixed order
+	names := make([]string, 0, len(classes))
+	for _, pc := range classes {
+		names = append(names, pc.name)
+	}
+	slices.Sort(names)
+	for _, name := range slices.Compact(names) {
+		b.WriteString("[:" + name + ":]")
+	}
+	slices.SortFunc(props, func(a, b *property) int {
+		if a.negated != b.negated {
+			if a.negated {
+				return 1
+			}
+			return -1
+		}
+		return strings.Compare(a.name, b.name)
+	})
+	props = slices.CompactFunc(props, func(a, b *property) bool {
+		return a.negated == b.negated && a.name == b.name
+	})
+	for _, prop := range props {
+		if prop.negated {
+			b.WriteString(`\P{` + prop.name + "}")
+		} else {
+			b.WriteString(`\p{` + prop.name + "}")
+		}
+	}
+	b.WriteByte(']')
+	return b.String(), false, end
+}
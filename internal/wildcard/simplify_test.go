@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package wildcard
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+// TestSimplify validates the canonical forms of patterns
+func TestSimplify(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opts    Options
+		want    string
+	}{
+		// Wildcard runs
+		{"**??*", Options{}, "*"},
+		{"a??b", Options{}, "a??b"},
+		{"a*?*b", Options{}, "a*b"},
+		{"a*?*b", Options{StrictQuestion: true}, "a?*b"},
+		{"**/a/**", Options{Separator: '/'}, "**/a/**"},
+		{"***/a/***", Options{Separator: '/'}, "**/a/**"},
+		{"a/**?/b", Options{Separator: '/'}, "a/*/b"},
+		{"a**b", Options{Separator: '/'}, "a*b"},
+
+		// Classes
+		{"[a]", Options{}, "a"},
+		{"x[.]y", Options{}, "x\\.y"},
+		{"x[.]y", Options{LiteralDot: true}, "x.y"},
+		{"[*]", Options{}, "\\*"},
+		{"[aa-c]", Options{}, "[a-c]"},
+		{"[c-ea-bz]", Options{}, "[a-ez]"},
+		{"[ba]", Options{}, "[ab]"},
+		{"[^a]", Options{}, "[!a]"},
+		{"[!a]", Options{}, "[!a]"},
+		{"[\\^]", Options{}, "^"},
+		{"[\\^a]", Options{}, "[\\^a]"},
+		{"[!^a]", Options{}, "[!^a]"},
+		{"[a\\-]", Options{}, "[\\-a]"},
+		{"[]a]", Options{}, "[\\]a]"},
+		{"[[:digit:][:alpha:]x[:alpha:]]", Options{}, "[x[:alpha:][:digit:]]"},
+		{"[/]", Options{Separator: '/'}, "[/]"},
+		{"[é]", Options{}, "[é]"},
+		{"[é]\\pL", Options{}, "é\\p{L}"},
+		{"[\\p{Lu}\\p{Greek}]", Options{}, "[\\p{Greek}\\p{Lu}]"},
+		{"\\\\[p]", Options{}, "\\\\p"},
+
+		// Escapes
+		{"\\a\\b", Options{}, "ab"},
+		{"\\*\\.\\?", Options{}, "\\*\\.\\?"},
+		{"\\.", Options{LiteralDot: true}, "."},
+		{"a,b}", Options{}, "a,b}"},
+		{"{a\\,b,c}", Options{}, "{a\\,b,c}"},
+		{"{[,],c}", Options{}, "{\\,,c}"},
+		{"\\é", Options{}, "é"},
+		{"a\\", Options{}, "a\\\\"},
+	}
+	for _, tc := range tests {
+		got, err := Simplify(tc.pattern, tc.opts)
+		if err != nil || got != tc.want {
+			t.Errorf("Simplify(%q, %+v) = %q, %v; expected %q", tc.pattern, tc.opts, got, err, tc.want)
+		}
+	}
+
+	if _, err := Simplify("[a", Options{}); !errors.Is(err, ErrBadPattern) {
+		t.Errorf("Simplify of a malformed pattern: expected ErrBadPattern, got %v", err)
+	}
+}
+
+// TestSimplifyDifferential validates on random patterns that canonical forms
+// are stable and match the same strings as the original
+func TestSimplifyDifferential(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	inputs := shortInputs(3)
+	for i := 0; i < 500; i++ {
+		pattern, opts := randomPattern(rng, 5), randomOpts(rng)
+
+		simple, err := Simplify(pattern, opts)
+		if err != nil {
+			t.Fatalf("Simplify(%q, %+v): %v", pattern, opts, err)
+		}
+		if again, _ := Simplify(simple, opts); again != simple {
+			t.Errorf("Simplify(%q, %+v) = %q, which simplifies to %q", pattern, opts, simple, again)
+		}
+
+		// Byte-oriented semantics, on short inputs
+		p1, _ := Compile(pattern, opts)
+		p2, err := Compile(simple, opts)
+		if err != nil {
+			t.Fatalf("Simplify(%q, %+v) = %q: %v", pattern, opts, simple, err)
+		}
+		for _, s := range inputs {
+			if want, got := MatchProgram(p1, s), MatchProgram(p2, s); got != want {
+				t.Errorf("Simplify(%q, %+v) = %q on %q: expected %v, got %v", pattern, opts, simple, s, want, got)
+			}
+		}
+
+		// Unicode-aware semantics, on every input
+		u1, _ := CompileFold(pattern, false, opts)
+		u2, _ := CompileFold(simple, false, opts)
+		if same, err := Equivalent(u1, u2); err != nil || !same {
+			t.Errorf("Simplify(%q, %+v) = %q is not equivalent: %v", pattern, opts, simple, err)
+		}
+	}
+}
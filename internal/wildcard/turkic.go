@@ -0,0 +1,61 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+// Package wildcard contains optimized wildcard matching implementations.
+// This file provides the Turkic special casing used by Turkish and Azerbaijani.
+// Their alphabets have a dotted and a dotless I, each with its own upper and
+// lower case: 'İ' pairs with 'i' and 'I' with 'ı', where the locale-neutral
+// folding pairs 'I' with 'i' and leaves 'İ' and 'ı' alone. Every other rune
+// folds as usual.
+package wildcard
+
+const (
+	capitalDottedI = 'İ' // İ LATIN CAPITAL LETTER I WITH DOT ABOVE
+	smallDotlessI  = 'ı' // ı LATIN SMALL LETTER DOTLESS I
+)
+
+// turkicFold returns the representative of r under Turkic case folding, the
+// lower case letter of its pair, and whether r is one of the four letters that
+// the Turkic rules fold differently.
+func turkicFold(r rune) (rune, bool) {
+	switch r {
+	case 'I', smallDotlessI:
+		return smallDotlessI, true
+	case 'i', capitalDottedI:
+		return 'i', true
+	}
+	return r, false
+}
+
+// turkicPair returns the other case of one of the four letters that the Turkic
+// rules fold differently.
+func turkicPair(r rune) rune {
+	switch r {
+	case 'I':
+		return smallDotlessI
+	case smallDotlessI:
+		return 'I'
+	case 'i':
+		return capitalDottedI
+	default:
+		return 'i'
+	}
+}
+
+// equalFoldTurkic performs case-insensitive rune comparison using Unicode simple
+// folding with the Turkic special casing of dotted and dotless I.
+func equalFoldTurkic(r1, r2 rune) bool {
+	f1, ok1 := turkicFold(r1)
+	f2, ok2 := turkicFold(r2)
+	if ok1 || ok2 {
+		return f1 == f2
+	}
+	return equalFoldRune(r1, r2)
+}
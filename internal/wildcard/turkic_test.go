@@ -0,0 +1,119 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package wildcard
+
+import (
+	"slices"
+	"testing"
+)
+
+// turkicCases validates the Turkic casing of dotted and dotless I
+var turkicCases = []struct {
+	pattern string
+	input   string
+	want    bool
+}{
+	// Dotted pair
+	{"İSTANBUL", "istanbul", true},
+	{"istanbul", "İSTANBUL", true},
+	{"İ", "I", false},
+
+	// Dotless pair
+	{"ISPARTA", "ısparta", true},
+	{"ısparta", "ISPARTA", true},
+	{"I", "i", false},
+	{"ı", "i", false},
+
+	// Other letters fold as usual
+	{"DİYARBAKIR", "diyarbakır", true},
+	{"DİYARBAKIR", "diyarbakir", false},
+	{"ŞIŞLI*", "şışlı merkez", true},
+	{"Kelvin", "kelvin", true}, // KELVIN SIGN
+
+	// Wildcards and classes
+	{"*ı*", "KIRMIZI", true},
+	{"*i*", "KIRMIZI", false},
+	{"[a-z]stanbul", "İstanbul", false}, // Classes stay case-sensitive
+	{"{ankara,izmir}", "İZMİR", true},
+}
+
+// TestTurkic validates Turkic casing in MatchInternalFold and in compiled programs
+func TestTurkic(t *testing.T) {
+	opts := Options{Turkic: true}
+	for _, c := range turkicCases {
+		if got, err := MatchInternalFoldWithOptions(c.pattern, c.input, true, opts); err != nil || got != c.want {
+			t.Errorf("MatchInternalFoldWithOptions(%q, %q): expected %v, got %v, %v", c.pattern, c.input, c.want, got, err)
+		}
+		if got, err := MatchInternalFoldWithOptions([]byte(c.pattern), []byte(c.input), true, opts); err != nil || got != c.want {
+			t.Errorf("MatchInternalFoldWithOptions([]byte %q, %q): expected %v, got %v, %v", c.pattern, c.input, c.want, got, err)
+		}
+
+		prog, err := CompileFold(c.pattern, true, opts)
+		if err != nil {
+			t.Fatalf("CompileFold(%q) failed: %v", c.pattern, err)
+		}
+		if got := MatchProgram(prog, c.input); got != c.want {
+			t.Errorf("CompileFold(%q) on %q: expected %v, got %v", c.pattern, c.input, c.want, got)
+		}
+	}
+
+	// The default folding stays locale-neutral
+	if ok, _ := MatchInternalFold("I", "i", true); !ok {
+		t.Errorf("Expected I to match i without Turkic casing")
+	}
+	if ok, _ := MatchInternalFold("İ", "i", true); ok {
+		t.Errorf("Expected İ not to match i without Turkic casing")
+	}
+}
+
+// TestTurkicOptions validates Turkic casing combined with the other folding options
+func TestTurkicOptions(t *testing.T) {
+	tests := []struct {
+		pattern string
+		input   string
+		opts    Options
+		want    bool
+	}{
+		{"[ı]", "I", Options{Turkic: true, FoldClasses: true}, true},
+		{"[i]", "I", Options{Turkic: true, FoldClasses: true}, false},
+		{"[!i]", "İ", Options{Turkic: true, FoldClasses: true}, false},
+		{"[a-z]*", "İzmir", Options{Turkic: true, FoldClasses: true}, true},
+		{"[a-z]*", "Isparta", Options{Turkic: true, FoldClasses: true}, false},
+		{"İ", "i", Options{Turkic: true, FullFold: true}, true},
+		{"İ", "i̇", Options{Turkic: true, FullFold: true}, false},
+		{"İ", "i̇", Options{FullFold: true}, true},
+		{"FI", "ﬁ", Options{Turkic: true, FullFold: true}, false},
+		{"Fİ", "ﬁ", Options{Turkic: true, FullFold: true}, true},
+	}
+	for _, tt := range tests {
+		prog, err := CompileFold(tt.pattern, true, tt.opts)
+		if err != nil {
+			t.Fatalf("CompileFold(%q) failed: %v", tt.pattern, err)
+		}
+		if got := MatchProgram(prog, tt.input); got != tt.want {
+			t.Errorf("CompileFold(%q, %+v) on %q: expected %v, got %v", tt.pattern, tt.opts, tt.input, tt.want, got)
+		}
+	}
+}
+
+// TestTurkicSet validates that pattern sets do not index literals under Turkic casing
+func TestTurkicSet(t *testing.T) {
+	p1, _ := CompileFold("IĞDIR*", true, Options{Turkic: true})
+	p2, _ := CompileFold("*izmir", true, Options{Turkic: true})
+	set := NewSet([]*Program{p1, p2})
+
+	if got := SetMatches(set, "ığdır merkez", nil); !slices.Equal(got, []int{0}) {
+		t.Errorf("Expected [0], got %v", got)
+	}
+	if got := SetMatches(set, "GÜZEL İZMİR", nil); !slices.Equal(got, []int{1}) {
+		t.Errorf("Expected [1], got %v", got)
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+// Package wildcard contains optimized wildcard matching implementations.
+// This file provides the policies for invalid UTF-8 in Unicode-aware matching.
+// By default an invalid byte decodes as utf8.RuneError, as in the standard
+// library, so under case folding it matches U+FFFD and any other invalid byte.
+// The opaque policy instead decodes each invalid byte to a rune of its own above
+// utf8.MaxRune, which only the same byte matches, and the reject policy refuses
+// patterns and inputs that are not valid UTF-8.
+package wildcard
+
+import (
+	"errors"
+	"unicode/utf8"
+)
+
+// ErrInvalidUTF8 indicates an input was not valid UTF-8 under the UTF8Reject policy.
+var ErrInvalidUTF8 = errors.New("input is not valid UTF-8")
+
+// UTF8Policy selects how Unicode-aware matching handles invalid UTF-8 in
+// patterns and inputs.
+type UTF8Policy uint8
+
+const (
+	// UTF8Replace decodes each invalid byte as utf8.RuneError (U+FFFD).
+	UTF8Replace UTF8Policy = iota
+
+	// UTF8Opaque treats each invalid byte as a character of its own, matched
+	// only by the same byte: `.` and `?` consume it and classes only contain it
+	// if it appears in them.
+	UTF8Opaque
+
+	// UTF8Reject reports invalid UTF-8 as an error: a PatternError with
+	// ReasonInvalidUTF8 for patterns and ErrInvalidUTF8 for inputs.
+	UTF8Reject
+)
+
+// opaqueRune returns the rune standing for the invalid byte b under UTF8Opaque.
+func opaqueRune(b byte) rune {
+	return utf8.MaxRune + 1 + rune(b)
+}
+
+// decodeChar decodes the rune starting at s[i] like decodeRune, except that an
+// invalid byte decodes to its opaque rune when opaque is set.
+func decodeChar[T ~string | ~[]byte](s T, i int, opaque bool) (rune, int) {
+	r, width := decodeRune(s, i)
+	if opaque && r == utf8.RuneError && width == 1 {
+		return opaqueRune(s[i]), 1
+	}
+	return r, width
+}
+
+// invalidUTF8 returns the offset of the first byte of s that is not part of a
+// valid UTF-8 encoding, or -1 if s is valid UTF-8.
+func invalidUTF8[T ~string | ~[]byte](s T) int {
+	for i := 0; i < len(s); {
+		if s[i] < utf8.RuneSelf {
+			i++
+			continue
+		}
+		r, width := decodeRune(s, i)
+		if r == utf8.RuneError && width == 1 {
+			return i
+		}
+		i += width
+	}
+	return -1
+}
+
+// rejects reports whether p refuses the input s under the UTF8Reject policy.
+func rejects[T ~string | ~[]byte](p *Program, s T) bool {
+	return p.invalid == UTF8Reject && invalidUTF8(s) >= 0
+}
+
+// matchCompiled matches s against prog on behalf of the MatchInternal entry
+// points, reporting an input refused under the UTF8Reject policy as ErrInvalidUTF8.
+func matchCompiled[T ~string | ~[]byte](prog *Program, s T) (bool, error) {
+	if rejects(prog, s) {
+		return false, ErrInvalidUTF8
+	}
+	return MatchProgram(prog, s), nil
+}
+
+// checkUTF8 returns a PatternError for the first invalid byte of pattern under
+// the UTF8Reject policy.
+func checkUTF8[T ~string | ~[]byte](pattern T, policy UTF8Policy) error {
+	if policy != UTF8Reject {
+		return nil
+	}
+	if i := invalidUTF8(pattern); i >= 0 {
+		return newPatternError(pattern, i, i+1, ReasonInvalidUTF8)
+	}
+	return nil
+}
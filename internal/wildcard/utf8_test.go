@@ -0,0 +1,120 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package wildcard
+
+import (
+	"errors"
+	"testing"
+)
+
+// utf8Cases validates each invalid UTF-8 policy on case-insensitive matching
+var utf8Cases = []struct {
+	pattern string
+	input   string
+	policy  UTF8Policy
+	want    bool
+}{
+	// Invalid bytes decode as U+FFFD by default
+	{"a\xffb", "A\xfeB", UTF8Replace, true},
+	{"a\uFFFDb", "a\xffb", UTF8Replace, true},
+	{"a.b", "a\xffb", UTF8Replace, true},
+
+	// Opaque invalid bytes only match themselves
+	{"a\xffb", "A\xffB", UTF8Opaque, true},
+	{"a\xffb", "a\xfeb", UTF8Opaque, false},
+	{"a\uFFFDb", "a\xffb", UTF8Opaque, false},
+	{"a\uFFFDb", "a\uFFFDb", UTF8Opaque, true},
+	{"a.b", "a\xffb", UTF8Opaque, true},
+	{"a?b", "a\xff\xfeb", UTF8Opaque, false},
+	{"a??b", "a\xff\xfeb", UTF8Opaque, true},
+	{"[\xff]", "\xff", UTF8Opaque, true},
+	{"[\xff]", "\xfe", UTF8Opaque, false},
+	{"[!\xff]", "\xfe", UTF8Opaque, true},
+	{"[[:alpha:]]", "\xff", UTF8Opaque, false},
+	{"\\\xff", "\xff", UTF8Opaque, true},
+	{"*\xc3", "ab\xc3", UTF8Opaque, true},
+	{"*\xc3", "abé", UTF8Opaque, false}, // A truncated é is not é
+
+	// Valid UTF-8 is unaffected by the reject policy
+	{"CAFÉ*", "café au lait", UTF8Reject, true},
+}
+
+// TestUTF8Policy validates the invalid UTF-8 policies in MatchInternalFold and in compiled programs
+func TestUTF8Policy(t *testing.T) {
+	for _, c := range utf8Cases {
+		opts := Options{InvalidUTF8: c.policy}
+		if got, err := MatchInternalFoldWithOptions(c.pattern, c.input, true, opts); err != nil || got != c.want {
+			t.Errorf("MatchInternalFoldWithOptions(%q, %q, %d): expected %v, got %v, %v", c.pattern, c.input, c.policy, c.want, got, err)
+		}
+		if got, err := MatchInternalFoldWithOptions([]byte(c.pattern), []byte(c.input), true, opts); err != nil || got != c.want {
+			t.Errorf("MatchInternalFoldWithOptions([]byte %q, %q, %d): expected %v, got %v, %v", c.pattern, c.input, c.policy, c.want, got, err)
+		}
+	}
+}
+
+// TestUTF8Reject validates that the reject policy reports invalid patterns and inputs
+func TestUTF8Reject(t *testing.T) {
+	opts := Options{InvalidUTF8: UTF8Reject}
+
+	_, err := MatchInternalFoldWithOptions("ab\xff*", "abc", true, opts)
+	var pe *PatternError
+	if !errors.As(err, &pe) || pe.Reason != ReasonInvalidUTF8 || pe.Offset != 2 {
+		t.Errorf("Expected an invalid UTF-8 PatternError at offset 2, got %v", err)
+	}
+	if _, err := CompileLike("%\xff", true, opts); !errors.Is(err, ErrBadPattern) {
+		t.Errorf("CompileLike: expected ErrBadPattern, got %v", err)
+	}
+
+	if ok, err := MatchInternalFoldWithOptions("ab*", "abc\xff", true, opts); ok || !errors.Is(err, ErrInvalidUTF8) {
+		t.Errorf("Expected ErrInvalidUTF8, got %v, %v", ok, err)
+	}
+	if ok, err := MatchInternalWithOptions(`\p{L}*`, "abc\xff", opts); ok || !errors.Is(err, ErrInvalidUTF8) {
+		t.Errorf("MatchInternalWithOptions: expected ErrInvalidUTF8, got %v, %v", ok, err)
+	}
+
+	// Compiled programs never match a rejected input
+	prog, _ := CompileFold("*", true, opts)
+	if MatchProgram(prog, "\xff") || MatchProgramSubmatch(prog, "\xff") != nil {
+		t.Errorf("Expected the program not to match invalid UTF-8")
+	}
+	if start, end := IndexProgram(prog, "\xff", 0); start != -1 || end != -1 {
+		t.Errorf("IndexProgram: expected -1 -1, got %d %d", start, end)
+	}
+	if got := FindAllProgram(prog, "\xff", -1); got != nil {
+		t.Errorf("FindAllProgram: expected nil, got %v", got)
+	}
+
+	// Byte-oriented programs compare bytes and accept any input
+	prog, err = Compile("a*", opts)
+	if err != nil || !MatchProgram(prog, "a\xff") {
+		t.Errorf("Compile: expected a match, got %v", err)
+	}
+}
+
+// TestEscapedRune validates that an escaped multi-byte character is compared as a whole
+func TestEscapedRune(t *testing.T) {
+	tests := []struct {
+		pattern string
+		input   string
+		fold    bool
+		want    bool
+	}{
+		{`\é`, "é", false, true},
+		{`\é`, "É", true, true},
+		{`\é`, "è", true, false},
+		{`caf\é*`, "café au lait", false, true},
+	}
+	for _, tt := range tests {
+		if got, err := MatchInternalFold(tt.pattern, tt.input, tt.fold); err != nil || got != tt.want {
+			t.Errorf("MatchInternalFold(%q, %q, %v): expected %v, got %v, %v", tt.pattern, tt.input, tt.fold, tt.want, got, err)
+		}
+	}
+}
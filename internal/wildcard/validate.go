@@ -0,0 +1,92 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+// Package wildcard contains optimized wildcard matching implementations.
+// This file provides up-front syntax checking of patterns, so that malformed
+// patterns are reported regardless of how far matching would get before reaching
+// the malformed part.
+package wildcard
+
+// Validate checks the syntax of pattern for MatchInternal and Compile and returns
+// a *PatternError describing the first problem found, or nil.
+func Validate[T ~string | ~[]byte](pattern T) error {
+	return validate(pattern, false)
+}
+
+// ValidateFold checks the syntax of pattern for MatchInternalFold and CompileFold,
+// whose character classes are made of runes rather than bytes.
+func ValidateFold[T ~string | ~[]byte](pattern T) error {
+	return validate(pattern, true)
+}
+
+// validate checks the syntax of pattern for the byte-oriented engine, or for the
+// Unicode engine if unicode is set, by compiling it: the compiler is the single
+// parser of the pattern syntax. Only character classes, brace groups and
+// property escapes can be malformed. Patterns with property escapes are matched
+// by the Unicode engine whichever engine they are given to.
+func validate[T ~string | ~[]byte](pattern T, unicode bool) error {
+	_, err := compile(pattern, unicode || hasProperty(pattern), false, Options{})
+	return err
+}
+
+// checkClasses checks the character classes and property escapes of a pattern
+// without brace groups, with the parsers the backtracking loops apply to them,
+// as byte classes or as rune classes if unicode is set. Unlike validate, it does
+// not build a program, which only the compiled engine needs.
+func checkClasses[T ~string | ~[]byte](pattern T, unicode bool) error {
+	for pIdx := 0; pIdx < len(pattern); {
+		switch pattern[pIdx] {
+		case wildcardEscape:
+			prop, end, err := parseProperty(pattern, pIdx)
+			if err != nil {
+				return err
+			}
+			if prop != nil {
+				pIdx = end
+				continue
+			}
+			// The escaped character is a literal; any continuation bytes of a
+			// multi-byte rune are skipped by the default case
+			pIdx += 2
+
+		case wildcardBracket:
+			var err error
+			if unicode {
+				_, pIdx, err = NewcharClassFold(pattern, pIdx)
+			} else {
+				_, pIdx, err = NewCharClass(pattern, pIdx)
+			}
+			if err != nil {
+				return err
+			}
+
+		default:
+			pIdx++
+		}
+	}
+	return nil
+}
+
+// hasSyntax reports whether pattern contains a `[` or `{` byte or a property
+// escape, i.e. whether it has any syntax that validate could reject.
+func hasSyntax[T ~string | ~[]byte](pattern T) bool {
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case wildcardBracket, wildcardBrace:
+			return true
+		case wildcardEscape:
+			if i+1 < len(pattern) && (pattern[i+1] == 'p' || pattern[i+1] == 'P') {
+				return true
+			}
+			i++ // Skip the escaped character
+		}
+	}
+	return false
+}
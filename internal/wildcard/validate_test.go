@@ -0,0 +1,135 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package wildcard
+
+import (
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// validateCases lists patterns with whether they are well-formed
+var validateCases = []struct {
+	pattern string
+	valid   bool
+}{
+	{"", true},
+	{"abc", true},
+	{"*.txt", true},
+	{"[abc]", true},
+	{"[]]", true},
+	{"[!a-z]*", true},
+	{"\\[", true},
+	{"a\\{", true},
+	{"{a,b}", true},
+	{"a}b,c", true},
+	{"[{]", true},
+	{"abc[", false},
+	{"[z-a]", false},
+	{"x*[a-", false},
+	{"[a\\", false},
+	{"{a,b", false},
+	{"{a,[b}", false},
+	{"*{a,{b,c}", false},
+}
+
+// TestValidate validates syntax checking for both engines
+func TestValidate(t *testing.T) {
+	for _, c := range validateCases {
+		for name, validate := range map[string]func(string) error{"Validate": Validate[string], "ValidateFold": ValidateFold[string]} {
+			err := validate(c.pattern)
+			if c.valid && err != nil {
+				t.Errorf("%s(%q): unexpected error %v", name, c.pattern, err)
+			}
+			if !c.valid && !errors.Is(err, ErrBadPattern) {
+				t.Errorf("%s(%q): expected ErrBadPattern, got %v", name, c.pattern, err)
+			}
+		}
+	}
+}
+
+// TestValidateAgreesWithCompile validates that Validate accepts exactly the patterns Compile accepts
+func TestValidateAgreesWithCompile(t *testing.T) {
+	patterns := []string{"[ü-é]", "[é-ü]", "[ü-z]"}
+	for _, c := range validateCases {
+		patterns = append(patterns, c.pattern)
+	}
+	for _, c := range baseTestCases {
+		patterns = append(patterns, c.pattern)
+	}
+
+	for _, p := range patterns {
+		_, compileErr := Compile(p, Options{})
+		if err := Validate(p); (err == nil) != (compileErr == nil) {
+			t.Errorf("Validate(%q) = %v, Compile error %v", p, err, compileErr)
+		}
+		_, compileErr = CompileFold(p, true, Options{})
+		if err := ValidateFold(p); (err == nil) != (compileErr == nil) {
+			t.Errorf("ValidateFold(%q) = %v, CompileFold error %v", p, err, compileErr)
+		}
+	}
+}
+
+// TestCheckClassesAgreesWithCompile validates that the check of the backtracking
+// loops accepts exactly the patterns without brace groups that Compile accepts
+func TestCheckClassesAgreesWithCompile(t *testing.T) {
+	patterns := []string{"[ü-é]", "[é-ü]", "\\p{Foo}", "[\\p{Lu]", "a\\pL[", "[[:alpha]"}
+	for _, c := range validateCases {
+		patterns = append(patterns, c.pattern)
+	}
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		patterns = append(patterns, randomPattern(rng, 5))
+	}
+
+	for _, p := range patterns {
+		if strings.IndexByte(p, wildcardBrace) >= 0 {
+			continue
+		}
+		if !hasProperty(p) {
+			_, compileErr := Compile(p, Options{})
+			if err := checkClasses(p, false); (err == nil) != (compileErr == nil) {
+				t.Errorf("checkClasses(%q, false) = %v, Compile error %v", p, err, compileErr)
+			}
+		}
+		_, compileErr := CompileFold(p, true, Options{})
+		if err := checkClasses(p, true); (err == nil) != (compileErr == nil) {
+			t.Errorf("checkClasses(%q, true) = %v, CompileFold error %v", p, err, compileErr)
+		}
+	}
+}
+
+// TestMatchReportsBadPatternEagerly validates that malformed patterns are reported
+// even when the input fails to match before the malformed part is reached
+func TestMatchReportsBadPatternEagerly(t *testing.T) {
+	cases := []struct {
+		pattern string
+		s       string
+	}{
+		{"abc[", "xyz"},
+		{"abc[z-a]", "xyz"},
+		{"a*[", ""},
+		{"x{a,b", "y"},
+	}
+
+	for _, c := range cases {
+		if _, err := MatchInternal(c.pattern, c.s); !errors.Is(err, ErrBadPattern) {
+			t.Errorf("MatchInternal(%q, %q): expected ErrBadPattern, got %v", c.pattern, c.s, err)
+		}
+		if _, err := MatchInternal([]byte(c.pattern), []byte(c.s)); !errors.Is(err, ErrBadPattern) {
+			t.Errorf("MatchInternal([]byte %q, %q): expected ErrBadPattern, got %v", c.pattern, c.s, err)
+		}
+		if _, err := MatchInternalFold(c.pattern, c.s, true); !errors.Is(err, ErrBadPattern) {
+			t.Errorf("MatchInternalFold(%q, %q): expected ErrBadPattern, got %v", c.pattern, c.s, err)
+		}
+	}
+}
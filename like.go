@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package gowild
+
+import "github.com/twinfer/gowild/internal/wildcard"
+
+// ErrNotExpressible indicates a pattern cannot be converted to the target syntax
+// because it uses a feature the target lacks.
+var ErrNotExpressible = wildcard.ErrNotExpressible
+
+// WithEscape sets the escape character of SQL LIKE patterns, as in
+// `LIKE pattern ESCAPE 'c'`. The default is backslash; WithEscape(0) disables
+// escaping, like an empty escape string. It has no effect on wildcard patterns.
+//
+// Example:
+//
+//	MatchLike(`50\%`, "50%")                  // true, as in LIKE '50\%'
+//	MatchLike("50!%", "50%", WithEscape('!')) // true, as in LIKE '50!%' ESCAPE '!'
+//	MatchLike(`50\%`, `50\x`, WithEscape(0))  // true, as in LIKE '50\%' ESCAPE ''
+func WithEscape(escape rune) Option {
+	return func(o *wildcard.Options) {
+		if escape == 0 {
+			escape = -1
+		}
+		o.Escape = escape
+	}
+}
+
+// MatchLike reports whether s matches the SQL LIKE pattern, following the
+// semantics of PostgreSQL:
+//
+//   - `%` matches any sequence of characters, including none
+//   - `_` matches exactly one character, newline included
+//   - The escape character (backslash unless set with WithEscape) makes the
+//     next character literal
+//   - Every other character matches itself, case-sensitively
+//
+// Characters are runes, not bytes. A pattern ending with the escape character is
+// malformed, reported as a *PatternError with reason ReasonTrailingEscape.
+//
+// Example:
+//
+//	MatchLike("100\\%", "100%")               // true
+//	MatchLike("a_c", "abc")                   // true
+//	MatchLike("a!_c", "a_c", WithEscape('!')) // true
+func MatchLike[T ~string | ~[]byte](pattern, s T, opts ...Option) (bool, error) {
+	prog, err := wildcard.CompileLike(pattern, false, buildOptions(opts))
+	if err != nil {
+		return false, err
+	}
+	return wildcard.MatchProgram(prog, s), nil
+}
+
+// MatchILike is the case-insensitive form of MatchLike, using the Unicode
+// folding of MatchFold.
+func MatchILike[T ~string | ~[]byte](pattern, s T, opts ...Option) (bool, error) {
+	prog, err := wildcard.CompileLike(pattern, true, buildOptions(opts))
+	if err != nil {
+		return false, err
+	}
+	return wildcard.MatchProgram(prog, s), nil
+}
+
+// CompileLike parses a SQL LIKE pattern for repeated case-sensitive matching,
+// with the semantics of MatchLike.
+func CompileLike(pattern string, opts ...Option) (*Pattern, error) {
+	prog, err := wildcard.CompileLike(pattern, false, buildOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return &Pattern{expr: pattern, prog: prog}, nil
+}
+
+// CompileILike parses a SQL LIKE pattern for repeated case-insensitive matching,
+// with the semantics of MatchILike.
+func CompileILike(pattern string, opts ...Option) (*Pattern, error) {
+	prog, err := wildcard.CompileLike(pattern, true, buildOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return &Pattern{expr: pattern, prog: prog}, nil
+}
+
+// ToLike converts a wildcard pattern into an equivalent LIKE pattern that uses
+// backslash as its escape character. `*` becomes `%` and literal `%`, `_` and
+// backslashes are escaped. `?`, `.`, character classes and brace groups cannot
+// be expressed in LIKE and yield an error wrapping ErrNotExpressible, except for
+// `?` next to a `*`, which the star absorbs.
+//
+// Example:
+//
+//	ToLike("report_*")  // "report\\_%"
+//	ToLike("file?.txt") // error wrapping ErrNotExpressible
+func ToLike(pattern string) (string, error) {
+	return wildcard.ToLike(pattern)
+}
+
+// FromLike converts a LIKE pattern into an equivalent wildcard pattern.
+// `%` becomes `*` and wildcard characters are escaped. `_` cannot be expressed,
+// as `.` does not match newlines, and yields an error wrapping ErrNotExpressible.
+// The escape character can be set with WithEscape.
+//
+// Example:
+//
+//	FromLike("%.txt") // "*\\.txt"
+func FromLike(pattern string, opts ...Option) (string, error) {
+	return wildcard.FromLike(pattern, buildOptions(opts))
+}
@@ -10,6 +10,7 @@ Neither the name of the copyright holder nor the names of its contributors may b
 package gowild
 
 import (
+	"fmt"
 	"path/filepath"
 	"regexp"
 	"testing"
@@ -98,6 +99,30 @@ func BenchmarkGoWildFold(b *testing.B) {
 	}
 }
 
+// BenchmarkGoWildCompiled tests matching with a pattern compiled once up front
+func BenchmarkGoWildCompiled(b *testing.B) {
+	for _, tc := range commonTestCases {
+		b.Run(tc.name, func(b *testing.B) {
+			p := MustCompile(tc.pattern)
+			for b.Loop() {
+				p.MatchString(tc.text)
+			}
+		})
+	}
+}
+
+// BenchmarkGoWildCompiledFold tests case-insensitive matching with a pattern compiled once up front
+func BenchmarkGoWildCompiledFold(b *testing.B) {
+	for _, tc := range commonTestCases {
+		b.Run(tc.name, func(b *testing.B) {
+			p := MustCompileFold(tc.pattern)
+			for b.Loop() {
+				p.MatchString(tc.text)
+			}
+		})
+	}
+}
+
 // BenchmarkFilepath tests path/filepath.Match performance on common patterns
 func BenchmarkFilepath(b *testing.B) {
 	for _, tc := range commonTestCases {
@@ -131,3 +156,40 @@ func BenchmarkRegexNotCompiled(b *testing.B) {
 		})
 	}
 }
+
+// routingPatterns builds a routing table of n patterns mixing prefix, suffix and inner literals
+func routingPatterns(n int) []string {
+	patterns := make([]string, n)
+	for i := range patterns {
+		switch i % 4 {
+		case 0:
+			patterns[i] = fmt.Sprintf("service-%d/*", i)
+		case 1:
+			patterns[i] = fmt.Sprintf("*.tenant%d.example.com", i)
+		case 2:
+			patterns[i] = fmt.Sprintf("*/user-%d/*", i)
+		default:
+			patterns[i] = fmt.Sprintf("api/v?/route%d/[a-z]*", i)
+		}
+	}
+	return patterns
+}
+
+// BenchmarkPatternSet tests single-pass matching of one input against a routing table
+func BenchmarkPatternSet(b *testing.B) {
+	set, err := NewPatternSet(routingPatterns(10000))
+	if err != nil {
+		b.Fatal(err)
+	}
+	for b.Loop() {
+		set.MatchString("api/v2/route9999/list")
+	}
+}
+
+// BenchmarkMatchMultiple tests matching one input against a routing table one pattern at a time
+func BenchmarkMatchMultiple(b *testing.B) {
+	patterns := routingPatterns(10000)
+	for b.Loop() {
+		MatchMultiple(patterns, "api/v2/route9999/list")
+	}
+}
@@ -0,0 +1,221 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package gowild
+
+import "github.com/twinfer/gowild/internal/wildcard"
+
+// Option configures optional matching behaviour. Options are accepted by Match,
+// MatchFold, the Compile functions and the PatternSet constructors.
+type Option func(*wildcard.Options)
+
+// WithSeparator enables path-aware matching with sep as the path separator:
+//
+//   - `*`, `?`, `.` and character classes never match sep
+//   - `**` forming a whole path segment matches zero or more segments, so
+//     `a/**/b` matches "a/b", "a/x/b" and "a/x/y/b", and a trailing `a/**`
+//     matches everything below "a/"
+//   - `**` that is not a whole segment, as in `a**b`, behaves like `*`
+//
+// Example:
+//
+//	Match("src/*.go", "src/main.go", WithSeparator('/'))        // true
+//	Match("src/*.go", "src/cmd/main.go", WithSeparator('/'))    // false
+//	Match("src/**/*.go", "src/cmd/main.go", WithSeparator('/')) // true
+func WithSeparator(sep rune) Option {
+	return func(o *wildcard.Options) {
+		o.Separator = sep
+	}
+}
+
+// WithStrictQuestion makes every `?` match exactly one character, as in shells,
+// fnmatch and filepath.Match. By default `?` matches zero or one character.
+//
+// Example:
+//
+//	Match("file?.txt", "file.txt")                        // true
+//	Match("file?.txt", "file.txt", WithStrictQuestion())  // false
+//	Match("file?.txt", "file1.txt", WithStrictQuestion()) // true
+func WithStrictQuestion() Option {
+	return func(o *wildcard.Options) {
+		o.StrictQuestion = true
+	}
+}
+
+// WithLiteralDot makes `.` match only a literal dot, as in shell globs. By default
+// `.` matches any single character except newline.
+//
+// Example:
+//
+//	Match("*.txt", "file_txt")                   // true
+//	Match("*.txt", "file_txt", WithLiteralDot()) // false
+//
+// Combined with WithStrictQuestion, `*`, `?`, `[...]` and `{a,b}` follow the
+// usual shell meaning:
+//
+//	Match("report-??.csv", "report-07.csv", WithStrictQuestion(), WithLiteralDot()) // true
+func WithLiteralDot() Option {
+	return func(o *wildcard.Options) {
+		o.LiteralDot = true
+	}
+}
+
+// WithFoldClasses makes character classes case-insensitive in MatchFold and
+// CompileFold, using Unicode simple folding: a character matches a class if any
+// of its case variants does. Without it, classes stay case-sensitive even when
+// folding, as in most glob implementations. It has no effect on Match.
+//
+// Example:
+//
+//	MatchFold("[a-z]*", "Hello")                     // false
+//	MatchFold("[a-z]*", "Hello", WithFoldClasses())  // true
+//	MatchFold("[!a-z]*", "Hello", WithFoldClasses()) // false
+func WithFoldClasses() Option {
+	return func(o *wildcard.Options) {
+		o.FoldClasses = true
+	}
+}
+
+// WithFullFold makes MatchFold and CompileFold use full Unicode case folding, in
+// which a character may fold to several: "STRASSE" matches "straße" and "fi"
+// matches 'ﬁ'. Wildcards still consume whole input characters, so `stra?e`
+// matches "straße" but not "strasse", and a literal never matches part of a
+// character. It has no effect on Match.
+//
+// Example:
+//
+//	MatchFold("STRASSE", "straße")                 // false
+//	MatchFold("STRASSE", "straße", WithFullFold()) // true
+func WithFullFold() Option {
+	return func(o *wildcard.Options) {
+		o.FullFold = true
+	}
+}
+
+// WithTurkic makes MatchFold, CompileFold and the other case-insensitive APIs
+// follow the special casing of Turkish and Azerbaijani: 'I' folds to the dotless
+// 'ı' and the dotted 'İ' to 'i', so that "İSTANBUL" matches "istanbul" but
+// "ISPARTA" no longer matches "isparta". Every other character folds as usual.
+// It has no effect on Match.
+//
+// Example:
+//
+//	MatchFold("DİYARBAKIR", "diyarbakır")              // false
+//	MatchFold("DİYARBAKIR", "diyarbakır", WithTurkic()) // true
+func WithTurkic() Option {
+	return func(o *wildcard.Options) {
+		o.Turkic = true
+	}
+}
+
+// WithIgnoreAccents makes matching accent-insensitive, as search boxes expect:
+// precomposed Latin letters such as 'é' match their base letter and combining
+// diacritical marks are ignored, in the pattern as in the input. A letter and
+// the marks following it still count as one character for `.`, `?` and
+// character classes, and a letter matches a class when either it or its base
+// letter is a member. Letters without a decomposition, such as 'ø' or 'ß', are
+// left alone. It applies to Match as well as MatchFold, and combines with the
+// other case folding options.
+//
+// Example:
+//
+//	MatchFold("cafe*", "Café au lait")                      // false
+//	MatchFold("cafe*", "Café au lait", WithIgnoreAccents()) // true
+//	Match("caf.", "cafe\u0301", WithIgnoreAccents())        // true
+func WithIgnoreAccents() Option {
+	return func(o *wildcard.Options) {
+		o.IgnoreAccents = true
+	}
+}
+
+// WithGraphemes makes `.`, `?`, `*` and character classes consume extended
+// grapheme clusters, as defined by UAX #29, instead of single runes, so that
+// patterns count characters the way users see them: a flag, an emoji ZWJ
+// sequence or a letter followed by combining marks is one character. A literal
+// must end on a cluster boundary, so `e` does not match the start of "é"
+// spelled as 'e' and a combining acute, and a class matches a cluster when its
+// first rune is a member. The segmentation tables are built into the package
+// for the Unicode version of the unicode package, as reported by unicode.Version:
+// Unicode 15.0 up to Go 1.26 and Unicode 17.0 from Go 1.27.
+//
+// Example:
+//
+//	MatchFold("flag.", "flag🇫🇷")                   // false
+//	MatchFold("flag.", "flag🇫🇷", WithGraphemes()) // true
+func WithGraphemes() Option {
+	return func(o *wildcard.Options) {
+		o.Graphemes = true
+	}
+}
+
+// WithNormalization makes matching insensitive to Unicode normalization, so that
+// canonically equivalent text is equal: 'é' typed precomposed (NFC) matches 'e'
+// followed by a combining acute accent (NFD), as in file names from macOS. The
+// input is not normalized up front; each character and the characters combining
+// with it are decomposed as they are compared, using composition tables built
+// into the package for the Unicode version of the unicode package, as reported
+// by unicode.Version: Unicode 15.0 up to Go 1.26 and Unicode 17.0 from Go 1.27.
+// Such a sequence counts as one character for `.`, `?` and character classes,
+// and a class sees its composed form, so `[é]` matches both spellings.
+//
+// Example:
+//
+//	MatchFold("résumé*", "re\u0301sume\u0301.pdf")                      // false
+//	MatchFold("résumé*", "re\u0301sume\u0301.pdf", WithNormalization()) // true
+func WithNormalization() Option {
+	return func(o *wildcard.Options) {
+		o.Normalize = true
+	}
+}
+
+// UTF8Policy selects how Unicode-aware matching handles invalid UTF-8.
+type UTF8Policy = wildcard.UTF8Policy
+
+// Policies for invalid UTF-8, selected with WithInvalidUTF8.
+const (
+	// UTF8Replace decodes each invalid byte as U+FFFD, like the standard
+	// library, so under case folding it matches U+FFFD and any other invalid
+	// byte. It is the default.
+	UTF8Replace = wildcard.UTF8Replace
+
+	// UTF8Opaque treats each invalid byte as a character of its own, matched
+	// only by the same byte.
+	UTF8Opaque = wildcard.UTF8Opaque
+
+	// UTF8Reject refuses invalid UTF-8: a pattern containing it is reported as a
+	// *PatternError with ReasonInvalidUTF8, and an input containing it as
+	// ErrInvalidUTF8 by MatchFold, or as no match by compiled patterns.
+	UTF8Reject = wildcard.UTF8Reject
+)
+
+// WithInvalidUTF8 selects how MatchFold, CompileFold and the other Unicode-aware
+// APIs handle invalid UTF-8, applying the same policy to the pattern and the
+// input. It has no effect on Match, which compares bytes, unless the pattern
+// contains property escapes.
+//
+// Example:
+//
+//	MatchFold("a\xffb", "a\xfeb")                              // true, nil: both bytes decode as U+FFFD
+//	MatchFold("a\xffb", "a\xfeb", WithInvalidUTF8(UTF8Opaque)) // false, nil
+//	MatchFold("a*", "a\xfe", WithInvalidUTF8(UTF8Reject))      // false, ErrInvalidUTF8
+func WithInvalidUTF8(policy UTF8Policy) Option {
+	return func(o *wildcard.Options) {
+		o.InvalidUTF8 = policy
+	}
+}
+
+// buildOptions applies opts to the zero Options value.
+func buildOptions(opts []Option) wildcard.Options {
+	var o wildcard.Options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
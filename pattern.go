@@ -0,0 +1,218 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package gowild
+
+import (
+	"strconv"
+
+	"github.com/twinfer/gowild/internal/wildcard"
+)
+
+// Pattern is a compiled wildcard pattern. The pattern is parsed once into an
+// instruction list (literals, character classes, `*` and `?` runs), so matching
+// it against many inputs avoids re-scanning the pattern on every call.
+//
+// A Pattern is safe for concurrent use by multiple goroutines.
+type Pattern struct {
+	expr string
+	prog *wildcard.Program
+}
+
+// Compile parses a wildcard pattern for case-sensitive matching.
+// The compiled Pattern uses the same wildcard semantics as Match.
+// If the pattern is malformed, Compile returns a *PatternError wrapping ErrBadPattern.
+//
+// Example:
+//
+//	p, err := Compile("*.txt")
+//	if err != nil {
+//		return err
+//	}
+//	p.MatchString("file.txt") // true
+func Compile(pattern string, opts ...Option) (*Pattern, error) {
+	prog, err := wildcard.Compile(pattern, buildOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return &Pattern{expr: pattern, prog: prog}, nil
+}
+
+// CompileFold parses a wildcard pattern for case-insensitive matching.
+// The compiled Pattern uses the same wildcard semantics as MatchFold.
+// If the pattern is malformed, CompileFold returns a *PatternError wrapping ErrBadPattern.
+func CompileFold(pattern string, opts ...Option) (*Pattern, error) {
+	prog, err := wildcard.CompileFold(pattern, true, buildOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return &Pattern{expr: pattern, prog: prog}, nil
+}
+
+// MustCompile is like Compile but panics if the pattern is malformed.
+// It simplifies safe initialization of global variables holding compiled patterns.
+func MustCompile(pattern string, opts ...Option) *Pattern {
+	p, err := Compile(pattern, opts...)
+	if err != nil {
+		panic(`gowild: Compile(` + strconv.Quote(pattern) + `): ` + err.Error())
+	}
+	return p
+}
+
+// MustCompileFold is like CompileFold but panics if the pattern is malformed.
+func MustCompileFold(pattern string, opts ...Option) *Pattern {
+	p, err := CompileFold(pattern, opts...)
+	if err != nil {
+		panic(`gowild: CompileFold(` + strconv.Quote(pattern) + `): ` + err.Error())
+	}
+	return p
+}
+
+// String returns the source text used to compile the pattern.
+func (p *Pattern) String() string {
+	return p.expr
+}
+
+// Match reports whether the byte slice b matches the pattern.
+// It is equivalent to MatchBytes and mirrors regexp.Regexp.Match.
+func (p *Pattern) Match(b []byte) bool {
+	return wildcard.MatchProgram(p.prog, b)
+}
+
+// MatchString reports whether the string s matches the pattern.
+func (p *Pattern) MatchString(s string) bool {
+	return wildcard.MatchProgram(p.prog, s)
+}
+
+// MatchBytes reports whether the byte slice b matches the pattern.
+func (p *Pattern) MatchBytes(b []byte) bool {
+	return wildcard.MatchProgram(p.prog, b)
+}
+
+// FindIndex returns a two-element slice of integers defining the location of the
+// leftmost substring of b matching the pattern, in the style of
+// regexp.Regexp.FindIndex. Among the matches starting at the leftmost position,
+// the longest is chosen. A nil result indicates no match.
+func (p *Pattern) FindIndex(b []byte) []int {
+	return location(wildcard.IndexProgram(p.prog, b, 0))
+}
+
+// FindStringIndex is like FindIndex but for a string input.
+func (p *Pattern) FindStringIndex(s string) []int {
+	return location(wildcard.IndexProgram(p.prog, s, 0))
+}
+
+// FindAllIndex returns the successive non-overlapping substrings of b matching
+// the pattern, as found by FindIndex. If n >= 0, it returns at most n matches.
+// A nil result indicates no match.
+//
+// Example:
+//
+//	p := MustCompile("id=[0-9]")
+//	p.FindAllIndex([]byte("id=1 id=x id=7"), -1) // [[0 4] [10 14]]
+func (p *Pattern) FindAllIndex(b []byte, n int) [][]int {
+	return wildcard.FindAllProgram(p.prog, b, n)
+}
+
+// FindAllStringIndex is like FindAllIndex but for a string input.
+func (p *Pattern) FindAllStringIndex(s string, n int) [][]int {
+	return wildcard.FindAllProgram(p.prog, s, n)
+}
+
+// location converts a start and end pair into a FindIndex result.
+func location(start, end int) []int {
+	if start < 0 {
+		return nil
+	}
+	return []int{start, end}
+}
+
+// NumSubexp returns the number of captures in the pattern. Every wildcard is a
+// capture, numbered in pattern order: `*`, `**`, `.`, a character class, and a
+// run of adjacent `*` and `?` wildcards, which is captured as a whole. Under
+// WithStrictQuestion, each `?` of a run without `*` is a capture of its own.
+func (p *Pattern) NumSubexp() int {
+	return p.prog.NumCaptures()
+}
+
+// PatternInfo describes what every string matched by a Pattern contains: its
+// literal prefix and suffix, the literal fragments it requires in order, the
+// lengths of the shortest and longest matches, MaxLen being -1 when a `*` or
+// `**` leaves it unbounded, and whether the pattern is a pure literal. Lengths
+// are in bytes, like `?` and `.` in Match, or in runes for patterns matched rune
+// by rune, such as those compiled with CompileFold or containing property
+// escapes. Only literals outside brace groups are reported, unescaped; with case
+// folding or other options relaxing the comparison of literals, a match contains
+// text equal to them under those options.
+type PatternInfo = wildcard.Info
+
+// Info returns the PatternInfo of the pattern, read from the same instructions
+// that matching executes, for example to index patterns in a database.
+//
+// Example:
+//
+//	MustCompile("log-????-*.txt", WithStrictQuestion(), WithLiteralDot()).Info()
+//	// {Prefix: "log-", Suffix: ".txt", Required: ["log-" "-" ".txt"], MinLen: 13, MaxLen: -1, Literal: false}
+func (p *Pattern) Info() PatternInfo {
+	return wildcard.ProgramInfo(p.prog)
+}
+
+// FindSubmatchIndex matches b against the pattern and returns index pairs
+// identifying the input consumed by each wildcard, in the style of
+// regexp.Regexp.FindSubmatchIndex. The first pair always spans the whole input,
+// since a pattern matches complete inputs. Wildcards take as few characters as
+// possible, from left to right, and those inside an untaken brace alternative
+// are reported as -1, -1. A nil result indicates no match.
+//
+// Example:
+//
+//	p := MustCompile("user-*-profile-?")
+//	p.FindSubmatchIndex([]byte("user-42-profile-x")) // [0 17 5 7 16 17]
+func (p *Pattern) FindSubmatchIndex(b []byte) []int {
+	return wildcard.MatchProgramSubmatch(p.prog, b)
+}
+
+// FindStringSubmatchIndex is like FindSubmatchIndex but for a string input.
+func (p *Pattern) FindStringSubmatchIndex(s string) []int {
+	return wildcard.MatchProgramSubmatch(p.prog, s)
+}
+
+// FindSubmatch matches b against the pattern and returns the whole input followed
+// by the text consumed by each wildcard, as described for FindSubmatchIndex.
+// Wildcards that did not take part in the match are nil. A nil result indicates
+// no match.
+func (p *Pattern) FindSubmatch(b []byte) [][]byte {
+	return submatches(b, wildcard.MatchProgramSubmatch(p.prog, b))
+}
+
+// FindStringSubmatch is like FindSubmatch but for a string input. Wildcards that
+// did not take part in the match are empty strings.
+//
+// Example:
+//
+//	p := MustCompile("user-*-profile-?")
+//	p.FindStringSubmatch("user-42-profile-x") // ["user-42-profile-x" "42" "x"]
+func (p *Pattern) FindStringSubmatch(s string) []string {
+	return submatches(s, wildcard.MatchProgramSubmatch(p.prog, s))
+}
+
+// submatches slices s at the index pairs of idx, or returns nil if idx is nil.
+func submatches[T ~string | ~[]byte](s T, idx []int) []T {
+	if idx == nil {
+		return nil
+	}
+	out := make([]T, len(idx)/2)
+	for i := range out {
+		if idx[2*i] >= 0 {
+			out[i] = s[idx[2*i]:idx[2*i+1]]
+		}
+	}
+	return out
+}
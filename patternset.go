@@ -0,0 +1,102 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package gowild
+
+import (
+	"slices"
+
+	"github.com/twinfer/gowild/internal/wildcard"
+)
+
+// PatternSet matches one input against many patterns in a single pass and reports
+// which of them match. The literal prefixes and suffixes of all patterns are merged
+// into a shared index, so the input is scanned once to find the candidate patterns
+// rather than once per pattern. Each pattern keeps the semantics of Compile
+// (or CompileFold for sets built with NewPatternSetFold).
+//
+// A PatternSet is safe for concurrent use by multiple goroutines.
+type PatternSet struct {
+	patterns []string
+	set      *wildcard.Set
+}
+
+// NewPatternSet compiles patterns into a case-sensitive PatternSet. The index of
+// each pattern in patterns is the index reported by the matching methods, and
+// opts apply to every pattern. If any pattern is malformed, NewPatternSet returns its *PatternError.
+//
+// Example:
+//
+//	set, err := NewPatternSet([]string{"foo*", "*bar", "baz[0-9]"})
+//	if err != nil {
+//		return err
+//	}
+//	set.MatchString("foobar") // [0 1]
+func NewPatternSet(patterns []string, opts ...Option) (*PatternSet, error) {
+	o := buildOptions(opts)
+	progs := make([]*wildcard.Program, len(patterns))
+	for i, p := range patterns {
+		prog, err := wildcard.Compile(p, o)
+		if err != nil {
+			return nil, err
+		}
+		progs[i] = prog
+	}
+	return &PatternSet{patterns: slices.Clone(patterns), set: wildcard.NewSet(progs)}, nil
+}
+
+// NewPatternSetFold compiles patterns into a case-insensitive PatternSet.
+// If any pattern is malformed, NewPatternSetFold returns its *PatternError.
+func NewPatternSetFold(patterns []string, opts ...Option) (*PatternSet, error) {
+	o := buildOptions(opts)
+	progs := make([]*wildcard.Program, len(patterns))
+	for i, p := range patterns {
+		prog, err := wildcard.CompileFold(p, true, o)
+		if err != nil {
+			return nil, err
+		}
+		progs[i] = prog
+	}
+	return &PatternSet{patterns: slices.Clone(patterns), set: wildcard.NewSet(progs)}, nil
+}
+
+// Len returns the number of patterns in the set.
+func (ps *PatternSet) Len() int {
+	return ps.set.Len()
+}
+
+// Patterns returns the source patterns of the set, in index order.
+func (ps *PatternSet) Patterns() []string {
+	return slices.Clone(ps.patterns)
+}
+
+// MatchString returns the indexes of all patterns that match s, in ascending order.
+// It returns nil if no pattern matches.
+func (ps *PatternSet) MatchString(s string) []int {
+	return wildcard.SetMatches(ps.set, s, nil)
+}
+
+// MatchBytes returns the indexes of all patterns that match b, in ascending order.
+// It returns nil if no pattern matches.
+func (ps *PatternSet) MatchBytes(b []byte) []int {
+	return wildcard.SetMatches(ps.set, b, nil)
+}
+
+// MatchAnyString reports whether at least one pattern matches s.
+// It is faster than MatchString as it stops at the first match.
+func (ps *PatternSet) MatchAnyString(s string) bool {
+	return wildcard.SetMatchAny(ps.set, s)
+}
+
+// MatchAnyBytes reports whether at least one pattern matches b.
+// It is faster than MatchBytes as it stops at the first match.
+func (ps *PatternSet) MatchAnyBytes(b []byte) bool {
+	return wildcard.SetMatchAny(ps.set, b)
+}
@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package gowild
+
+import "github.com/twinfer/gowild/internal/wildcard"
+
+// QuoteMeta returns s with every wildcard character (`*`, `?`, `.`, `[`, `{` and
+// backslash) escaped, so that the result is a pattern matching exactly s. Use it
+// to embed user-supplied text in a pattern, where a `.` or `[` would otherwise
+// become a wildcard.
+//
+// Example:
+//
+//	QuoteMeta("v1.2[beta]*") // "v1\\.2\\[beta]\\*"
+func QuoteMeta[T ~string | ~[]byte](s T) T {
+	return wildcard.QuoteMeta(s)
+}
+
+// Join concatenates patterns into one pattern that matches the concatenation of
+// what each piece matches. Each piece must be a valid pattern, so that an open
+// class or brace group cannot swallow the next piece; otherwise Join returns the
+// *PatternError of the first malformed piece. A trailing backslash stays a
+// literal backslash, and stars meeting at a piece boundary are merged into one,
+// so that they never form a `**` globstar. Literal text should be quoted with
+// QuoteMeta.
+//
+// Example:
+//
+//	Join("tenant-", QuoteMeta(id), "-*") // "tenant-acme\\.eu-*" for id "acme.eu"
+func Join(pieces ...string) (string, error) {
+	return wildcard.Join(pieces...)
+}
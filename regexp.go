@@ -0,0 +1,58 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package gowild
+
+import "github.com/twinfer/gowild/internal/wildcard"
+
+// ToRegexp converts a wildcard pattern into an equivalent RE2 expression for the
+// regexp package, anchored at both ends. The expression matches the strings the
+// pattern matches case-sensitively, character by character: it agrees with Match
+// on ASCII text, while for other text `?`, `.` and classes consume whole runes as
+// in MatchFold. `*` and `?` match newlines, `.` does not, and options such as
+// WithSeparator, WithStrictQuestion and WithLiteralDot are honoured. Options RE2
+// cannot express, such as WithGraphemes, yield an error wrapping
+// ErrNotExpressible.
+//
+// Example:
+//
+//	ToRegexp("*.{jpg,png}", WithLiteralDot()) // `^(?:(?s:.)*\.(?:jpg|png))$`
+//	ToRegexp("file?.txt")                     // `^(?:file(?s:.)?[^\n]txt)$`
+func ToRegexp(pattern string, opts ...Option) (string, error) {
+	return wildcard.ToRegexp(pattern, false, buildOptions(opts))
+}
+
+// ToRegexpFold is the case-insensitive form of ToRegexp, matching the strings
+// that MatchFold matches. Case folding becomes the (?i) flag, and character
+// classes stay case-sensitive unless WithFoldClasses is given.
+//
+// Example:
+//
+//	ToRegexpFold("HELLO*") // `(?i)^(?:HELLO(?s:.)*)$`
+func ToRegexpFold(pattern string, opts ...Option) (string, error) {
+	return wildcard.ToRegexp(pattern, true, buildOptions(opts))
+}
+
+// FromRegexp converts an RE2 expression into a wildcard pattern matching the same
+// strings. The expression must match the whole input to match, so an unanchored
+// start or end becomes `*`. Literals, classes, alternations and optional parts
+// convert to their wildcard forms, and case-insensitive letters to classes of
+// their case variants. Since `*` also matches newlines, only `(?s:.*)` converts
+// to `*`; `.*`, other repetitions and assertions such as `\b` yield an error
+// wrapping ErrNotExpressible. Like ToRegexp, the result agrees with Match on
+// ASCII text and consumes whole runes on other text.
+//
+// Example:
+//
+//	FromRegexp(`^(?:jpe?g|png)$`) // "{jp{e,}g,png}"
+//	FromRegexp(`(?s)^.*\.txt$`)   // "*\\.txt"
+func FromRegexp(expr string) (string, error) {
+	return wildcard.FromRegexp(expr)
+}
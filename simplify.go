@@ -0,0 +1,38 @@
+/*
+Copyright (c) 2025 twinfer.com contact@twinfer.com Copyright (c) 2025 Khalid Daoud mohamed.khalid@gmail.com
+
+Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+
+Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+*/
+
+package gowild
+
+import "github.com/twinfer/gowild/internal/wildcard"
+
+// Simplify returns the canonical form of pattern: an equivalent pattern for Match
+// and Compile with the same options, written the same way for patterns differing
+// only in spelling, so that rules can be deduplicated or cached by it. Runs of `*`
+// and `?` are merged as matching merges them, classes of a single character
+// become literals, ranges are merged and sorted, and only characters that would
+// otherwise be special are escaped. Brace alternatives keep their order. A
+// malformed pattern is returned unchanged, so it still fails to compile.
+//
+// Since classes stay case-sensitive under MatchFold while literals do not, a class
+// of a single letter such as `[a]` is only equivalent to its canonical form under
+// Match, or under MatchFold with WithFoldClasses.
+//
+// Example:
+//
+//	Simplify("**??*")                     // "*"
+//	Simplify("log[.][aa-cx]")             // "log\\.[a-cx]"
+//	Simplify("a*?b", WithStrictQuestion()) // "a?*b"
+func Simplify(pattern string, opts ...Option) string {
+	simple, err := wildcard.Simplify(pattern, buildOptions(opts))
+	if err != nil {
+		return pattern
+	}
+	return simple
+}
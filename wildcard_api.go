@@ -22,6 +22,12 @@ Neither the name of the copyright holder nor the names of its contributors may b
 //
 //   - Match: ASCII-optimized case-sensitive wildcard matching
 //   - MatchFold: Unicode-aware case-insensitive wildcard matching
+//   - Compile/CompileFold: Parse a pattern once into a reusable Pattern
+//   - MatchCaptures: Match and report what each wildcard consumed
+//   - Index/FindAllIndex: Find the substrings of an input matching a pattern
+//   - Validate: Check the syntax of a pattern without matching it
+//   - MatchLike/MatchILike: SQL LIKE and ILIKE patterns (`%`, `_`, ESCAPE)
+//   - NewPatternSet/NewPatternSetFold: Match one input against many patterns in one pass
 //
 // The functions automatically route to the appropriate implementation for optimal performance.
 //
@@ -33,7 +39,42 @@ Neither the name of the copyright holder nor the names of its contributors may b
 //   - `[abc]`: Matches any character in the set (a, b, or c)
 //   - `[!abc]` or `[^abc]`: Matches any character not in the set
 //   - `[a-z]`: Matches any character in the range a to z
-//   - `\*`, `\?`, `\.`, `\[`: Matches the literal character
+//   - `[[:alpha:]]`: Matches any character of a POSIX named class: alnum, alpha,
+//     blank, cntrl, digit, graph, lower, print, punct, space, upper or xdigit.
+//     Match uses the ASCII definitions and MatchFold Unicode-aware ones, so
+//     `[[:alpha:]]` matches 'é' only with MatchFold
+//   - `{a,b}`: Matches any of the comma-separated alternatives, which may be nested
+//     and contain wildcards; `,` and `}` are only special inside a group, and an
+//     unclosed group is reported as ErrBadPattern
+//   - `\p{L}`, `\p{Han}`, `\pL`: Matches any character of a Unicode general
+//     category or script, and `\P{...}` any character outside it; usable on
+//     their own or inside `[...]`. Patterns using them are matched rune by rune,
+//     by Match as well as MatchFold
+//   - `\*`, `\?`, `\.`, `\[`, `\{`: Matches the literal character
+//
+// # Shell Semantics:
+//
+// `?` matches zero or one character and `.` any character except newline. The
+// WithStrictQuestion and WithLiteralDot options select the shell meaning instead,
+// exactly one character for `?` and a literal dot for `.`:
+//
+//	Match("file?.txt", "file.txt", WithStrictQuestion(), WithLiteralDot()) // false
+//
+// # Grapheme Clusters:
+//
+// Wildcards consume single runes, so `.` matches only half of a flag made of two
+// regional indicators. With the WithGraphemes option, `.`, `?`, `*` and character
+// classes consume extended grapheme clusters (UAX #29) instead:
+//
+//	MatchFold("flag.", "flag🇫🇷", WithGraphemes()) // true
+//
+// # Path Matching:
+//
+// By default no character is special in the input. With the WithSeparator option,
+// wildcards stop at the given path separator and `**` as a whole path segment
+// matches zero or more segments:
+//
+//	Match("src/**/*.go", "src/cmd/main.go", WithSeparator('/')) // true
 //
 // # Type Support:
 //
@@ -43,13 +84,15 @@ Neither the name of the copyright holder nor the names of its contributors may b
 //
 // # Character Classes:
 //
-// Character classes ([abc], [a-z], [!xyz]) are always case-sensitive, even when
-// using MatchFold. This maintains compatibility with standard glob behavior.
+// Character classes ([abc], [a-z], [!xyz]) are case-sensitive by default, even when
+// using MatchFold. This maintains compatibility with standard glob behavior. The
+// WithFoldClasses option makes them case-insensitive under MatchFold.
 //
 // # Performance Guidance:
 //
 // - Use Match() for ASCII-only patterns when maximum speed is needed
 // - Use MatchFold() for Unicode patterns or when case-insensitive matching is required
+// - Use Compile() or CompileFold() when the same pattern is matched against many inputs
 // - ASCII-only matching provides 2-5x performance improvement over Unicode-aware matching
 package gowild
 
@@ -62,6 +105,51 @@ import (
 // ErrBadPattern indicates a pattern was malformed.
 var ErrBadPattern = wildcard.ErrBadPattern
 
+// ErrInvalidUTF8 indicates an input was not valid UTF-8 under the UTF8Reject policy.
+var ErrInvalidUTF8 = wildcard.ErrInvalidUTF8
+
+// PatternError describes a malformed pattern with the byte offset and text of the
+// offending token and the reason it was rejected. Every PatternError wraps
+// ErrBadPattern, so errors.Is(err, ErrBadPattern) still reports true:
+//
+//	_, err := Match("file[z-a].txt", "file.txt")
+//	var pe *PatternError
+//	if errors.As(err, &pe) {
+//		fmt.Println(pe.Offset, pe.Token, pe.Reason) // 5 z-a inverted range in character class
+//	}
+type PatternError = wildcard.PatternError
+
+// Reasons reported in PatternError.Reason.
+const (
+	ReasonUnclosedClass   = wildcard.ReasonUnclosedClass
+	ReasonInvertedRange   = wildcard.ReasonInvertedRange
+	ReasonDanglingEscape  = wildcard.ReasonDanglingEscape
+	ReasonUnclosedBrace   = wildcard.ReasonUnclosedBrace
+	ReasonExpectedClass   = wildcard.ReasonExpectedClass
+	ReasonTrailingEscape  = wildcard.ReasonTrailingEscape
+	ReasonUnknownClass    = wildcard.ReasonUnknownClass
+	ReasonUnknownProperty = wildcard.ReasonUnknownProperty
+	ReasonInvalidUTF8     = wildcard.ReasonInvalidUTF8
+)
+
+// Validate checks the syntax of pattern without matching it. It returns a
+// *PatternError for the first malformed character class or unclosed brace group,
+// or nil if the pattern is accepted by both Match and MatchFold.
+//
+// Match, MatchFold and MatchMultiple perform the same check before matching, so a
+// malformed pattern is reported whatever the input.
+//
+// Example:
+//
+//	Validate("*.{jpg,png}") // nil
+//	Validate("abc[")        // syntax error in pattern "abc[" at offset 3: unclosed character class "["
+func Validate[T ~string | ~[]byte](pattern T) error {
+	if err := wildcard.Validate(pattern); err != nil {
+		return err
+	}
+	return wildcard.ValidateFold(pattern)
+}
+
 // Match returns true if the pattern matches the input data using case-sensitive comparison.
 // It supports two types:
 //
@@ -77,8 +165,18 @@ var ErrBadPattern = wildcard.ErrBadPattern
 //	Match([]byte("*.txt"), []byte("file.txt")) // byte slice matching
 //	Match("file?.txt", "file.txt")           // ? matches zero characters
 //	Match("file?.txt", "fileX.txt")          // ? matches one character
-func Match[T ~string | ~[]byte](pattern, s T) (bool, error) {
-	return wildcard.MatchInternal(pattern, s)
+//
+// Options such as WithSeparator and WithStrictQuestion adjust the wildcard
+// semantics for this call.
+//
+// `\p` and `\P` start a Unicode property escape rather than escaping the letters
+// p and P, as they did in earlier versions, so a bare `\p`, or `[\p]` inside a
+// class, is reported as ErrBadPattern. Write the letters unescaped instead.
+func Match[T ~string | ~[]byte](pattern, s T, opts ...Option) (bool, error) {
+	if len(opts) == 0 {
+		return wildcard.MatchInternal(pattern, s)
+	}
+	return wildcard.MatchInternalWithOptions(pattern, s, buildOptions(opts))
 }
 
 // MatchFold returns true if the pattern matches the input data using case-insensitive
@@ -96,16 +194,53 @@ func Match[T ~string | ~[]byte](pattern, s T) (bool, error) {
 //	MatchFold("CAFÉ*", "café au lait")           // Unicode case-insensitive
 //	MatchFold("FILE?.TXT", "file.txt")           // ? matches zero characters
 //	MatchFold("FILE?.TXT", "fileX.txt")          // ? matches one character
-func MatchFold[T ~string | ~[]byte](pattern, s T) (bool, error) {
-	return wildcard.MatchInternalFold(pattern, s, true)
+//
+// Case folding is simple by default, one character to one character; the
+// WithFullFold option enables full case folding, so that "SS" matches 'ß'. Folding
+// is locale-neutral unless the WithTurkic option selects the Turkic casing of
+// dotted and dotless I, and the WithIgnoreAccents option also ignores accents,
+// so that "cafe" matches "café". The WithNormalization option matches NFC and NFD
+// spellings of the same text alike.
+// Options are handled as in Match.
+func MatchFold[T ~string | ~[]byte](pattern, s T, opts ...Option) (bool, error) {
+	if len(opts) == 0 {
+		return wildcard.MatchInternalFold(pattern, s, true)
+	}
+	return wildcard.MatchInternalFoldWithOptions(pattern, s, true, buildOptions(opts))
+}
+
+// MatchCaptures matches s against the compiled pattern, as Compile would, and
+// returns the part of s consumed by each wildcard, in pattern order. The boolean
+// result reports whether s matched, always agreeing with Match given the same
+// options; captures is nil if it did not.
+// Captures are per run of wildcards rather than per wildcard: adjacent `*` and
+// `?` wildcards, such as `*?` or `??`, yield a single capture, except that each
+// `?` of a run without `*` yields its own under WithStrictQuestion. See
+// Pattern.NumSubexp for the captures of a pattern and Pattern.FindSubmatchIndex
+// for how the input is split between wildcards.
+//
+// Example:
+//
+//	caps, ok, err := MatchCaptures("user-*-profile-?", "user-42-profile-x")
+//	// caps: ["42" "x"], ok: true
+func MatchCaptures[T ~string | ~[]byte](pattern, s T, opts ...Option) ([]T, bool, error) {
+	prog, err := wildcard.Compile(pattern, buildOptions(opts))
+	if err != nil {
+		return nil, false, err
+	}
+	caps := submatches(s, wildcard.MatchProgramSubmatch(prog, s))
+	if caps == nil {
+		return nil, false, nil
+	}
+	return caps[1:], true, nil
 }
 
 // MatchMultiple concurrently matches a single input against multiple patterns(case ensitive).
 // It returns a slice of booleans where each element corresponds to the pattern
 // at the same index.
 //
-// If any pattern is malformed, it returns an error. The order of results corresponds to
-// the order of input patterns.
+// If any pattern is malformed, it returns the error of the first one without matching.
+// The order of results corresponds to the order of input patterns.
 //
 // Example:
 //
@@ -113,6 +248,13 @@ func MatchFold[T ~string | ~[]byte](pattern, s T) (bool, error) {
 //	matches, err := MatchMultiple(patterns, "foobar")
 //	// matches will be [true, false, false]
 func MatchMultiple[S ~string | ~[]byte](patterns []S, s S) ([]bool, error) {
+	// Report the first malformed pattern in order, before doing any matching
+	for _, p := range patterns {
+		if err := wildcard.Validate(p); err != nil {
+			return nil, err
+		}
+	}
+
 	results := make([]bool, len(patterns))
 	// Use an error channel to capture an error from any goroutine.
 	errChan := make(chan error, 1)
@@ -151,8 +293,8 @@ func MatchMultiple[S ~string | ~[]byte](patterns []S, s S) ([]bool, error) {
 // It returns a slice of booleans where each element corresponds to the pattern
 // at the same index.
 //
-// If any pattern is malformed, it returns an error. The order of results corresponds to
-// the order of input patterns.
+// If any pattern is malformed, it returns the error of the first one without matching.
+// The order of results corresponds to the order of input patterns.
 //
 // Example:
 //
@@ -160,6 +302,13 @@ func MatchMultiple[S ~string | ~[]byte](patterns []S, s S) ([]bool, error) {
 //	matches, err := MatchMultiple(patterns, "foobar")
 //	// matches will be [true, true, false]
 func MatchFoldMultiple[S ~string | ~[]byte](patterns []S, s S) ([]bool, error) {
+	// Report the first malformed pattern in order, before doing any matching
+	for _, p := range patterns {
+		if err := wildcard.ValidateFold(p); err != nil {
+			return nil, err
+		}
+	}
+
 	results := make([]bool, len(patterns))
 	// Use an error channel to capture an error from any goroutine.
 	errChan := make(chan error, 1)